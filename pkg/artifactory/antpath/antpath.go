@@ -0,0 +1,277 @@
+// Package antpath implements parsing, validation and matching of the Ant-style path patterns used by Artifactory for
+// repository `includes_pattern`/`excludes_pattern`, webhook criteria and permission target sections, e.g.
+// `org/apache/**`, `**/*.jar`, `com/acme/?oo/*`.
+package antpath
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	PathSeparator    = "/"
+	PatternSeparator = ","
+	AnyPath          = "**"
+	// DefaultIncludesPattern is used by Artifactory when `includes_pattern` is empty.
+	DefaultIncludesPattern = "**/*"
+)
+
+// SplitPatterns splits a comma-separated pattern list, trimming whitespace around each item and dropping empty ones.
+func SplitPatterns(patterns string) []string {
+	var result []string
+	for _, pattern := range strings.Split(patterns, PatternSeparator) {
+		pattern = strings.TrimSpace(pattern)
+		if pattern != "" {
+			result = append(result, pattern)
+		}
+	}
+	return result
+}
+
+// Match reports whether path matches the Ant-style pattern.
+//
+// `**` matches zero or more directories, `*` matches zero or more characters within a single path segment and `?`
+// matches exactly one character. A pattern ending with `/` is treated as if it ended with `/**`. Leading slashes
+// of both the pattern and the path are ignored.
+func Match(pattern, path string) bool {
+	path = strings.TrimPrefix(path, PathSeparator)
+
+	return matchSegments(normalizedSegments(pattern), tokenize(path))
+}
+
+// MatchAny reports whether path matches at least one of the patterns.
+func MatchAny(patterns []string, path string) bool {
+	for _, pattern := range patterns {
+		if Match(pattern, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// regexSyntax lists characters that are common in regular expressions but have no special meaning in Ant patterns.
+// They are valid literal characters of a path, e.g. `lib(1).jar`.
+const regexSyntax = "^$()[]{}|\\"
+
+// Validate checks a single pattern. It returns an error for malformed patterns Artifactory would reject or misread,
+// and warnings for well-formed patterns that can never match or do not behave as they look. An empty pattern is
+// valid, Artifactory uses it as the default of the permission target includes.
+func Validate(pattern string) (warnings []string, err error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	if pattern != strings.TrimSpace(pattern) {
+		return nil, fmt.Errorf("pattern %q must not have leading or trailing whitespace", pattern)
+	}
+	if strings.Contains(pattern, PatternSeparator) {
+		return nil, fmt.Errorf("pattern %q must not contain %q", pattern, PatternSeparator)
+	}
+	if i := strings.IndexAny(pattern, regexSyntax); i != -1 {
+		warnings = append(warnings, fmt.Sprintf("pattern %q contains regular expression syntax %q, it's matched literally, only Ant-style wildcards (*, **, ?) are supported", pattern, pattern[i]))
+	}
+
+	segments := tokenize(strings.TrimSuffix(strings.TrimPrefix(pattern, PathSeparator), PathSeparator))
+	for _, segment := range segments {
+		switch {
+		case segment == "":
+			warnings = append(warnings, fmt.Sprintf("pattern %q contains an empty path segment and can never match", pattern))
+		case segment == "." || segment == "..":
+			warnings = append(warnings, fmt.Sprintf("pattern %q contains a relative path segment %q and can never match", pattern, segment))
+		case segment != AnyPath && strings.Contains(segment, AnyPath):
+			warnings = append(warnings, fmt.Sprintf("pattern %q: %q is only special as a whole path segment, inside %q it behaves like '*'", pattern, AnyPath, segment))
+		}
+	}
+
+	return warnings, nil
+}
+
+// ValidateList checks a comma-separated pattern list, e.g. the value of a repository `includes_pattern`. An empty
+// list is valid. Artifactory trims whitespace around list items, so it only produces a warning here.
+func ValidateList(patterns string) (warnings []string, errs []error) {
+	if strings.TrimSpace(patterns) == "" {
+		return nil, nil
+	}
+
+	for _, pattern := range strings.Split(patterns, PatternSeparator) {
+		trimmed := strings.TrimSpace(pattern)
+		if trimmed == "" {
+			errs = append(errs, fmt.Errorf("pattern list %q contains an empty item", patterns))
+			continue
+		}
+		if trimmed != pattern {
+			warnings = append(warnings, fmt.Sprintf("pattern list %q has whitespace around %q", patterns, trimmed))
+		}
+		ws, err := Validate(trimmed)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		warnings = append(warnings, ws...)
+	}
+
+	return warnings, errs
+}
+
+// MatchesEverything reports whether the pattern matches every possible path, e.g. `**`, `**/*` or `*/**`.
+func MatchesEverything(pattern string) bool {
+	segments := normalizedSegments(pattern)
+
+	var anyPathCount int
+	var others []string
+	for _, segment := range segments {
+		if segment == AnyPath {
+			anyPathCount++
+		} else {
+			others = append(others, segment)
+		}
+	}
+
+	switch len(others) {
+	case 0:
+		return anyPathCount > 0
+	case 1:
+		return anyPathCount > 0 && strings.Trim(others[0], "*") == ""
+	default:
+		return false
+	}
+}
+
+// ExcludesEverything reports whether the excludes filter out every path the includes (`**/*` when empty) let through.
+// The check is conservative: it only detects excludes that match everything, excludes equal to an include and
+// excludes of the form `prefix/**` covering an include under the same literal prefix.
+func ExcludesEverything(includes, excludes []string) bool {
+	if len(excludes) == 0 {
+		return false
+	}
+	for _, exclude := range excludes {
+		if MatchesEverything(exclude) {
+			return true
+		}
+	}
+
+	if len(includes) == 0 {
+		includes = []string{DefaultIncludesPattern}
+	}
+	for _, include := range includes {
+		covered := false
+		for _, exclude := range excludes {
+			if covers(exclude, include) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+func covers(exclude, include string) bool {
+	excludeSegments := normalizedSegments(exclude)
+	includeSegments := normalizedSegments(include)
+
+	if strings.Join(excludeSegments, PathSeparator) == strings.Join(includeSegments, PathSeparator) {
+		return true
+	}
+
+	// exclude is a literal prefix followed by '**' only
+	prefixLen := len(excludeSegments)
+	for prefixLen > 0 && excludeSegments[prefixLen-1] == AnyPath {
+		prefixLen--
+	}
+	if prefixLen == len(excludeSegments) || prefixLen > len(includeSegments) {
+		return false
+	}
+	for i := 0; i < prefixLen; i++ {
+		if strings.ContainsAny(excludeSegments[i], "*?") || excludeSegments[i] != includeSegments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizedSegments(pattern string) []string {
+	pattern = strings.TrimPrefix(strings.TrimSpace(pattern), PathSeparator)
+	if strings.HasSuffix(pattern, PathSeparator) {
+		pattern += AnyPath
+	}
+	return tokenize(pattern)
+}
+
+// IsIncluded evaluates comma-separated include and exclude lists the same way Artifactory does for repositories:
+// a path is included when it matches one of the includes (`**/*` when empty) and none of the excludes.
+func IsIncluded(includesPattern, excludesPattern, path string) bool {
+	includes := SplitPatterns(includesPattern)
+	if len(includes) == 0 {
+		includes = []string{DefaultIncludesPattern}
+	}
+
+	return MatchAny(includes, path) && !MatchAny(SplitPatterns(excludesPattern), path)
+}
+
+func tokenize(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	return strings.Split(s, PathSeparator)
+}
+
+func matchSegments(patternSegments, pathSegments []string) bool {
+	if len(patternSegments) == 0 {
+		return len(pathSegments) == 0
+	}
+
+	if patternSegments[0] == AnyPath {
+		// collapse consecutive '**' segments, they are equivalent to a single one
+		rest := patternSegments[1:]
+		for len(rest) > 0 && rest[0] == AnyPath {
+			rest = rest[1:]
+		}
+		if len(rest) == 0 {
+			return true
+		}
+		for i := 0; i <= len(pathSegments); i++ {
+			if matchSegments(rest, pathSegments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(pathSegments) == 0 || !matchSegment(patternSegments[0], pathSegments[0]) {
+		return false
+	}
+
+	return matchSegments(patternSegments[1:], pathSegments[1:])
+}
+
+// matchSegment matches a single path segment against a pattern segment containing `*` and `?` wildcards.
+func matchSegment(pattern, segment string) bool {
+	p, s := []rune(pattern), []rune(segment)
+	pi, si := 0, 0
+	starIdx, matchIdx := -1, 0
+
+	for si < len(s) {
+		switch {
+		case pi < len(p) && (p[pi] == '?' || p[pi] == s[si]):
+			pi++
+			si++
+		case pi < len(p) && p[pi] == '*':
+			starIdx = pi
+			matchIdx = si
+			pi++
+		case starIdx != -1:
+			pi = starIdx + 1
+			matchIdx++
+			si = matchIdx
+		default:
+			return false
+		}
+	}
+
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+
+	return pi == len(p)
+}
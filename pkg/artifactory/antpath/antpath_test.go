@@ -0,0 +1,136 @@
+package antpath_test
+
+import (
+	"testing"
+
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/antpath"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitPatterns(t *testing.T) {
+	assert.Equal(t, []string{"**/*", "foo/**"}, antpath.SplitPatterns("**/*, foo/**"))
+	assert.Equal(t, []string{"a/b"}, antpath.SplitPatterns(" ,a/b,, "))
+	assert.Empty(t, antpath.SplitPatterns(""))
+}
+
+func TestMatch(t *testing.T) {
+	testCases := []struct {
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{"**/*", "com/acme/foo/1.0/foo.jar", true},
+		{"**/*", "foo.jar", true},
+		{"**", "com/acme/foo/1.0/foo.jar", true},
+		{"com/acme/**", "com/acme/foo/1.0/foo.jar", true},
+		{"com/acme/**", "com/acme", true},
+		{"com/acme/", "com/acme/foo/1.0/foo.jar", true},
+		{"/com/acme/**", "/com/acme/foo.jar", true},
+		{"com/acme/*", "com/acme/foo/1.0/foo.jar", false},
+		{"com/acme/*", "com/acme/foo", true},
+		{"**/*.jar", "com/acme/foo/1.0/foo.jar", true},
+		{"**/*.jar", "com/acme/foo/1.0/foo.pom", false},
+		{"com/**/1.0/*.jar", "com/acme/foo/1.0/foo.jar", true},
+		{"com/**/**/1.0/*.jar", "com/1.0/foo.jar", true},
+		{"com/**/2.0/*.jar", "com/acme/foo/1.0/foo.jar", false},
+		{"com/acme/f?o/**", "com/acme/foo/1.0/foo.jar", true},
+		{"com/acme/f?o/**", "com/acme/fo/1.0/foo.jar", false},
+		{"com/acme/*-SNAPSHOT/**", "com/acme/1.0-SNAPSHOT/foo.jar", true},
+		{"com/*/foo*/**", "com/acme/foobar/1.0/foo.jar", true},
+		{"org/**", "com/acme/foo/1.0/foo.jar", false},
+		{"com/Acme/**", "com/acme/foo.jar", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.pattern+" "+tc.path, func(t *testing.T) {
+			assert.Equal(t, tc.expected, antpath.Match(tc.pattern, tc.path))
+		})
+	}
+}
+
+func TestIsIncluded(t *testing.T) {
+	const path = "com/acme/foo/1.0/foo.jar"
+
+	assert.True(t, antpath.IsIncluded("", "", path))
+	assert.True(t, antpath.IsIncluded("org/**, com/acme/**", "", path))
+	assert.False(t, antpath.IsIncluded("org/**", "", path))
+	assert.False(t, antpath.IsIncluded("**/*", "**/*.jar", path))
+	assert.True(t, antpath.IsIncluded("**/*", "**/*.pom", path))
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		pattern  string
+		isError  bool
+		warnings int
+	}{
+		{"**/*", false, 0},
+		{"org/apache/**", false, 0},
+		{"com/acme/?oo/*.jar", false, 0},
+		{"", false, 0},
+		{" foo/**", true, 0},
+		{"foo/** ", true, 0},
+		{"foo/**,bar/**", true, 0},
+		{"^foo/.*$", false, 1},
+		{"foo/(bar|baz)/**", false, 1},
+		{"foo/[0-9]/*", false, 1},
+		{"foo\\bar", false, 1},
+		{"foo//bar", false, 1},
+		{"foo/../bar", false, 1},
+		{"foo**/bar", false, 1},
+		{"lib(1).jar", false, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.pattern, func(t *testing.T) {
+			warnings, err := antpath.Validate(tc.pattern)
+			assert.Equal(t, tc.isError, err != nil, "error: %v", err)
+			assert.Len(t, warnings, tc.warnings)
+		})
+	}
+}
+
+func TestValidateList(t *testing.T) {
+	warnings, errs := antpath.ValidateList("")
+	assert.Empty(t, warnings)
+	assert.Empty(t, errs)
+
+	warnings, errs = antpath.ValidateList("**/*,foo/**")
+	assert.Empty(t, warnings)
+	assert.Empty(t, errs)
+
+	warnings, errs = antpath.ValidateList("**/*, foo/**")
+	assert.Len(t, warnings, 1)
+	assert.Empty(t, errs)
+
+	warnings, errs = antpath.ValidateList("**/*,foo/(bar|baz)/**")
+	assert.Len(t, warnings, 1)
+	assert.Empty(t, errs)
+
+	_, errs = antpath.ValidateList("**/*,,foo/**")
+	assert.Len(t, errs, 1)
+
+	warnings, errs = antpath.ValidateList("foo//bar,baz/**")
+	assert.Len(t, warnings, 1)
+	assert.Empty(t, errs)
+}
+
+func TestMatchesEverything(t *testing.T) {
+	for _, pattern := range []string{"**", "**/*", "/**", "*/**", "**/**/*", "**/**"} {
+		assert.True(t, antpath.MatchesEverything(pattern), pattern)
+	}
+	for _, pattern := range []string{"*", "*/*", "foo/**", "**/*.jar", ""} {
+		assert.False(t, antpath.MatchesEverything(pattern), pattern)
+	}
+}
+
+func TestExcludesEverything(t *testing.T) {
+	assert.False(t, antpath.ExcludesEverything(nil, nil))
+	assert.True(t, antpath.ExcludesEverything(nil, []string{"**"}))
+	assert.True(t, antpath.ExcludesEverything([]string{"com/acme/**"}, []string{"**/*"}))
+	assert.True(t, antpath.ExcludesEverything([]string{"com/acme/**"}, []string{"com/acme/**"}))
+	assert.True(t, antpath.ExcludesEverything([]string{"com/acme/**", "org/acme/*.jar"}, []string{"com/**", "org/**"}))
+	assert.False(t, antpath.ExcludesEverything([]string{"com/acme/**", "org/acme/**"}, []string{"com/**"}))
+	assert.False(t, antpath.ExcludesEverything(nil, []string{"com/**"}))
+	assert.False(t, antpath.ExcludesEverything([]string{"com/acme/**"}, []string{"com/*/foo/**"}))
+}
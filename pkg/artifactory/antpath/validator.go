@@ -0,0 +1,66 @@
+package antpath
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// ValidatePatternList is a schema.SchemaValidateDiagFunc for attributes holding a comma-separated pattern list, such as
+// `includes_pattern`. Malformed patterns are errors, patterns that can never match or look unintended are warnings.
+func ValidatePatternList(value interface{}, path cty.Path) diag.Diagnostics {
+	patterns, ok := value.(string)
+	if !ok {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid pattern list",
+				Detail:        "expected type of value to be string",
+				AttributePath: path,
+			},
+		}
+	}
+
+	var diags diag.Diagnostics
+	warnings, errs := ValidateList(patterns)
+	for _, err := range errs {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid Ant-style pattern",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+	}
+	for _, warning := range warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Suspicious Ant-style pattern",
+			Detail:        warning,
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+// ValidateExcludesPatternList is ValidatePatternList for exclude lists. It also warns when one of the excludes
+// matches every path, so nothing gets through whatever the includes are.
+//
+// SDKv2 cannot return warnings from CustomizeDiff, so a full include/exclude check is only done where both
+// attributes are visible to a validator, like in the permission target resource.
+func ValidateExcludesPatternList(value interface{}, path cty.Path) diag.Diagnostics {
+	diags := ValidatePatternList(value, path)
+	if diags.HasError() {
+		return diags
+	}
+
+	if ExcludesEverything(nil, SplitPatterns(value.(string))) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Exclude patterns exclude everything",
+			Detail:        "excludes " + value.(string) + " match every path, no artifact will be included",
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
@@ -0,0 +1,95 @@
+package antpath
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure our implementation satisfies the validator.Set interface.
+var _ validator.Set = &patternSetValidator{}
+
+type patternSetValidator struct {
+	includesAttribute string
+}
+
+// Description returns a plaintext string describing the validator.
+func (v patternSetValidator) Description(_ context.Context) string {
+	return "each value must be an Ant-style path pattern, e.g. org/apache/**"
+}
+
+// MarkdownDescription returns a Markdown formatted string describing the validator.
+func (v patternSetValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateSet performs the validation logic for the validator.
+func (v patternSetValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	// If the current attribute configuration is null or unknown, there
+	// cannot be any value comparisons, so exit early without error.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	patterns, known := knownStrings(req.ConfigValue)
+	for _, pattern := range patterns {
+		warnings, err := Validate(pattern)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid Ant-style pattern", err.Error())
+		}
+		for _, warning := range warnings {
+			resp.Diagnostics.AddAttributeWarning(req.Path, "Suspicious Ant-style pattern", warning)
+		}
+	}
+
+	if v.includesAttribute == "" || !known || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var includesValue types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName(v.includesAttribute), &includesValue)...)
+	if resp.Diagnostics.HasError() || includesValue.IsUnknown() {
+		return
+	}
+
+	includes, known := knownStrings(includesValue)
+	if known && ExcludesEverything(includes, patterns) {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Exclude patterns exclude everything",
+			fmt.Sprintf("excludes [%s] filter out every path matched by includes [%s], nothing will be included",
+				strings.Join(patterns, ", "), strings.Join(includes, ", ")),
+		)
+	}
+}
+
+func knownStrings(set types.Set) ([]string, bool) {
+	var result []string
+	for _, element := range set.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsUnknown() {
+			return result, false
+		}
+		if !value.IsNull() {
+			result = append(result, value.ValueString())
+		}
+	}
+	return result, true
+}
+
+// PatternSetValidator validates each value of a set as a single Ant-style pattern. Malformed patterns are errors,
+// patterns that can never match are warnings.
+func PatternSetValidator() validator.Set {
+	return &patternSetValidator{}
+}
+
+// ExcludesPatternSetValidator is PatternSetValidator for exclude sets. It also warns when the excludes filter out
+// everything matched by the sibling includes attribute.
+func ExcludesPatternSetValidator(includesAttribute string) validator.Set {
+	return &patternSetValidator{
+		includesAttribute: includesAttribute,
+	}
+}
@@ -0,0 +1,343 @@
+package datasource
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository"
+)
+
+const AqlSearchEndpoint = "artifactory/api/search/aql"
+
+// AqlIncludedFields are the fields of the items returned by the structured queries.
+var AqlIncludedFields = []string{
+	"repo", "path", "name", "type", "size", "created", "created_by", "modified", "modified_by", "updated",
+	"actual_md5", "actual_sha1", "sha256",
+}
+
+var AqlSortFields = []string{"repo", "path", "name", "size", "created", "modified", "updated"}
+
+// AqlQuery is a structured AQL query on the files of Artifactory.
+type AqlQuery struct {
+	Repositories []string
+	PathPattern  string
+	NamePattern  string
+	Properties   map[string]string
+	CreatedAfter string
+	SortBy       []string
+	SortOrder    string
+	Limit        int
+}
+
+// BuildAqlQuery returns the AQL of the query. Values are JSON encoded, so they can't escape their criterion.
+func BuildAqlQuery(query AqlQuery) (string, error) {
+	criteria := []map[string]interface{}{
+		{"type": map[string]string{"$eq": "file"}},
+	}
+
+	if len(query.Repositories) > 0 {
+		var repos []map[string]interface{}
+		for _, repo := range query.Repositories {
+			repos = append(repos, map[string]interface{}{"repo": map[string]string{"$eq": repo}})
+		}
+		criteria = append(criteria, map[string]interface{}{"$or": repos})
+	}
+	if query.PathPattern != "" {
+		criteria = append(criteria, map[string]interface{}{"path": map[string]string{"$match": query.PathPattern}})
+	}
+	if query.NamePattern != "" {
+		criteria = append(criteria, map[string]interface{}{"name": map[string]string{"$match": query.NamePattern}})
+	}
+	for _, name := range sortedKeys(query.Properties) {
+		criteria = append(criteria, map[string]interface{}{"@" + name: map[string]string{"$eq": query.Properties[name]}})
+	}
+	if query.CreatedAfter != "" {
+		criteria = append(criteria, map[string]interface{}{"created": map[string]string{"$gt": query.CreatedAfter}})
+	}
+
+	find, err := marshalAql(map[string]interface{}{"$and": criteria})
+	if err != nil {
+		return "", err
+	}
+	include, err := marshalAql(AqlIncludedFields)
+	if err != nil {
+		return "", err
+	}
+
+	aql := fmt.Sprintf("items.find(%s).include(%s)", find, strings.Trim(string(include), "[]"))
+
+	if len(query.SortBy) > 0 {
+		order := query.SortOrder
+		if order == "" {
+			order = "asc"
+		}
+		sortBy, err := marshalAql(map[string][]string{"$" + order: query.SortBy})
+		if err != nil {
+			return "", err
+		}
+		aql += fmt.Sprintf(".sort(%s)", sortBy)
+	}
+	if query.Limit > 0 {
+		aql += fmt.Sprintf(".limit(%d)", query.Limit)
+	}
+
+	return aql, nil
+}
+
+// marshalAql JSON encodes a part of an AQL query, without escaping the HTML characters AQL doesn't unescape.
+func marshalAql(v interface{}) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// AqlItem is an item returned by an AQL query.
+type AqlItem struct {
+	Repo       string `json:"repo"`
+	Path       string `json:"path"`
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	Size       int    `json:"size"`
+	Created    string `json:"created"`
+	CreatedBy  string `json:"created_by"`
+	Modified   string `json:"modified"`
+	ModifiedBy string `json:"modified_by"`
+	Updated    string `json:"updated"`
+	ActualMd5  string `json:"actual_md5"`
+	ActualSha1 string `json:"actual_sha1"`
+	Sha256     string `json:"sha256"`
+}
+
+type AqlResult struct {
+	Results []AqlItem `json:"results"`
+}
+
+// FileInfo returns the item as a FileInfo, downloaded from baseUrl.
+func (item AqlItem) FileInfo(baseUrl string) FileInfo {
+	path := item.Name
+	if item.Path != "" && item.Path != "." {
+		path = item.Path + "/" + item.Name
+	}
+	return FileInfo{
+		Repo:         item.Repo,
+		Path:         path,
+		Created:      item.Created,
+		CreatedBy:    item.CreatedBy,
+		LastModified: item.Modified,
+		ModifiedBy:   item.ModifiedBy,
+		LastUpdated:  item.Updated,
+		DownloadUri:  fmt.Sprintf("%s/artifactory/%s/%s", strings.TrimSuffix(baseUrl, "/"), item.Repo, path),
+		Size:         item.Size,
+		Checksums: Checksums{
+			Md5:    item.ActualMd5,
+			Sha1:   item.ActualSha1,
+			Sha256: item.Sha256,
+		},
+	}
+}
+
+var structuredQueryKeys = []string{"repositories", "path_pattern", "name_pattern", "properties", "created_after", "sort_by", "limit"}
+
+// aqlCriteriaKeys are the arguments restricting the items searched, one of them is required to not search all the
+// files of Artifactory.
+var aqlCriteriaKeys = []string{"query", "repositories", "path_pattern", "name_pattern", "properties", "created_after"}
+
+func ArtifactoryAqlSearch() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAqlSearchRead,
+
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  aqlCriteriaKeys,
+				ConflictsWith: structuredQueryKeys,
+				Description: "Raw AQL query, e.g. `items.find({\"repo\":\"libs-release-local\"})`. Conflicts with the " +
+					"arguments of the structured query.",
+			},
+			"repositories": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: aqlCriteriaKeys,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: repository.RepoKeyValidator},
+				Description:  "Keys of the repositories to search in. Default to all the repositories.",
+			},
+			"path_pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: aqlCriteriaKeys,
+				Description:  "Pattern the path of the files matches, with `*` and `?` wildcards, e.g. `com/example/*`.",
+			},
+			"name_pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: aqlCriteriaKeys,
+				Description:  "Pattern the name of the files matches, with `*` and `?` wildcards, e.g. `*.jar`.",
+			},
+			"properties": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				AtLeastOneOf: aqlCriteriaKeys,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  "Values of properties the files have, e.g. `{ release = \"ga\" }`.",
+			},
+			"created_after": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				AtLeastOneOf:     aqlCriteriaKeys,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+				Description:      "Only search the files created after this time, in RFC 3339 format.",
+			},
+			"sort_by": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(AqlSortFields, false))},
+				Description: fmt.Sprintf("Fields to sort the files by. Supported values: %s.", strings.Join(AqlSortFields, ", ")),
+			},
+			"sort_order": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "asc",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"asc", "desc"}, false)),
+				Description:      "`asc` or `desc`. Default to `asc`.",
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "Maximum number of files returned.",
+			},
+			"aql": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "AQL query posted to Artifactory.",
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Items found, in the order returned by Artifactory.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository":    {Type: schema.TypeString, Computed: true},
+						"path":          {Type: schema.TypeString, Computed: true},
+						"name":          {Type: schema.TypeString, Computed: true},
+						"type":          {Type: schema.TypeString, Computed: true},
+						"size":          {Type: schema.TypeInt, Computed: true},
+						"created":       {Type: schema.TypeString, Computed: true},
+						"created_by":    {Type: schema.TypeString, Computed: true},
+						"last_modified": {Type: schema.TypeString, Computed: true},
+						"modified_by":   {Type: schema.TypeString, Computed: true},
+						"last_updated":  {Type: schema.TypeString, Computed: true},
+						"download_uri":  {Type: schema.TypeString, Computed: true},
+						"md5":           {Type: schema.TypeString, Computed: true},
+						"sha1":          {Type: schema.TypeString, Computed: true},
+						"sha256":        {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+		Description: "Searches items with an AQL query, either raw or built from the structured query arguments.",
+	}
+}
+
+func searchAql(client *resty.Client, aql string) ([]AqlItem, error) {
+	result := AqlResult{}
+	_, err := client.R().
+		SetHeader("Content-Type", "text/plain").
+		SetBody(aql).
+		SetResult(&result).
+		Post(AqlSearchEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search with AQL query %s: %s", aql, err)
+	}
+	return result.Results, nil
+}
+
+func dataSourceAqlSearchRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(utilsdk.ProvderMetadata).Client
+
+	aql := d.Get("query").(string)
+	if aql == "" {
+		properties := map[string]string{}
+		for name, value := range d.Get("properties").(map[string]interface{}) {
+			properties[name] = value.(string)
+		}
+		var err error
+		aql, err = BuildAqlQuery(AqlQuery{
+			Repositories: utilsdk.CastToStringArr(d.Get("repositories").([]interface{})),
+			PathPattern:  d.Get("path_pattern").(string),
+			NamePattern:  d.Get("name_pattern").(string),
+			Properties:   properties,
+			CreatedAfter: d.Get("created_after").(string),
+			SortBy:       utilsdk.CastToStringArr(d.Get("sort_by").([]interface{})),
+			SortOrder:    d.Get("sort_order").(string),
+			Limit:        d.Get("limit").(int),
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	items, err := searchAql(client, aql)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	results := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		fileInfo := item.FileInfo(client.BaseURL)
+		results = append(results, map[string]interface{}{
+			"repository":    fileInfo.Repo,
+			"path":          fileInfo.Path,
+			"name":          item.Name,
+			"type":          item.Type,
+			"size":          fileInfo.Size,
+			"created":       fileInfo.Created,
+			"created_by":    fileInfo.CreatedBy,
+			"last_modified": fileInfo.LastModified,
+			"modified_by":   fileInfo.ModifiedBy,
+			"last_updated":  fileInfo.LastUpdated,
+			"download_uri":  fileInfo.DownloadUri,
+			"md5":           fileInfo.Checksums.Md5,
+			"sha1":          fileInfo.Checksums.Sha1,
+			"sha256":        fileInfo.Checksums.Sha256,
+		})
+	}
+
+	hash := sha256.Sum256([]byte(aql))
+	d.SetId(hex.EncodeToString(hash[:]))
+
+	setValue := utilsdk.MkLens(d)
+	setValue("aql", aql)
+	errors := setValue("results", results)
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed to pack AQL search results %q", errors)
+	}
+
+	return nil
+}
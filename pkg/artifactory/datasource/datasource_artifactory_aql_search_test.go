@@ -0,0 +1,133 @@
+package datasource_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/datasource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildAqlQuery(t *testing.T) {
+	aql, err := datasource.BuildAqlQuery(datasource.AqlQuery{
+		Repositories: []string{"libs-release-local", "libs-staging-local"},
+		PathPattern:  "com/example/*",
+		NamePattern:  "*.jar",
+		Properties:   map[string]string{"release": "ga", "team": "a\"b"},
+		CreatedAfter: "2023-01-01T00:00:00Z",
+		SortBy:       []string{"created"},
+		SortOrder:    "desc",
+		Limit:        1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `items.find({"$and":[`+
+		`{"type":{"$eq":"file"}},`+
+		`{"$or":[{"repo":{"$eq":"libs-release-local"}},{"repo":{"$eq":"libs-staging-local"}}]},`+
+		`{"path":{"$match":"com/example/*"}},`+
+		`{"name":{"$match":"*.jar"}},`+
+		`{"@release":{"$eq":"ga"}},`+
+		`{"@team":{"$eq":"a\"b"}},`+
+		`{"created":{"$gt":"2023-01-01T00:00:00Z"}}]})`+
+		`.include("repo","path","name","type","size","created","created_by","modified","modified_by","updated","actual_md5","actual_sha1","sha256")`+
+		`.sort({"$desc":["created"]})`+
+		`.limit(1)`, aql)
+}
+
+func TestBuildAqlQuery_escape(t *testing.T) {
+	aql, err := datasource.BuildAqlQuery(datasource.AqlQuery{
+		NamePattern: `*"}}]}).include("*&<>`,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `items.find({"$and":[`+
+		`{"type":{"$eq":"file"}},`+
+		`{"name":{"$match":"*\"}}]}).include(\"*&<>"}}]})`+
+		`.include("repo","path","name","type","size","created","created_by","modified","modified_by","updated","actual_md5","actual_sha1","sha256")`, aql)
+}
+
+func TestArtifactoryAqlSearch_criteriaRequired(t *testing.T) {
+	r := datasource.ArtifactoryAqlSearch()
+
+	diags := r.Validate(sdkterraform.NewResourceConfigRaw(map[string]interface{}{"sort_by": []interface{}{"created"}, "limit": 1}))
+	assert.True(t, diags.HasError())
+
+	for _, config := range []map[string]interface{}{
+		{"query": `items.find({"repo":"libs-release-local"})`},
+		{"name_pattern": "*.jar", "limit": 1},
+		{"properties": map[string]interface{}{"release": "ga"}},
+	} {
+		assert.False(t, r.Validate(sdkterraform.NewResourceConfigRaw(config)).HasError(), "config %v", config)
+	}
+}
+
+func TestAqlItemFileInfo(t *testing.T) {
+	fileInfo := datasource.AqlItem{
+		Repo:       "libs-release-local",
+		Path:       "com/example",
+		Name:       "lib-1.0.jar",
+		Size:       42,
+		ActualSha1: "sha1",
+		Sha256:     "sha256",
+	}.FileInfo("https://example.jfrog.io/")
+	assert.Equal(t, "com/example/lib-1.0.jar", fileInfo.Path)
+	assert.Equal(t, "https://example.jfrog.io/artifactory/libs-release-local/com/example/lib-1.0.jar", fileInfo.DownloadUri)
+	assert.Equal(t, 42, fileInfo.Size)
+	assert.Equal(t, datasource.Checksums{Sha1: "sha1", Sha256: "sha256"}, fileInfo.Checksums)
+
+	rootFileInfo := datasource.AqlItem{Repo: "generic-local", Path: ".", Name: "file.txt"}.FileInfo("https://example.jfrog.io")
+	assert.Equal(t, "file.txt", rootFileInfo.Path)
+	assert.Equal(t, "https://example.jfrog.io/artifactory/generic-local/file.txt", rootFileInfo.DownloadUri)
+}
+
+func TestAccDataSourceAqlSearch(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("aql-", "data.artifactory_aql_search")
+	_, rawFqrn, rawName := testutil.MkNames("aql-raw-", "data.artifactory_aql_search")
+	repoName := fmt.Sprintf("maven-local-%d", testutil.RandomInt())
+
+	config := fmt.Sprintf(`
+		data "artifactory_aql_search" "%s" {
+			repositories = ["%s"]
+			name_pattern = "multi1-*.jar"
+			sort_by      = ["created"]
+			sort_order   = "desc"
+			limit        = 1
+		}
+
+		data "artifactory_aql_search" "%s" {
+			query = "items.find({\"repo\":\"%s\"})"
+		}
+	`, name, repoName, rawName, repoName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.CreateRepo(t, repoName, "local", "maven", true, true)
+			uploadTwoArtifacts(t, repoName)
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			acctest.DeleteRepo(t, repoName)
+			return nil
+		},
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "results.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "results.0.repository", repoName),
+					resource.TestCheckResourceAttr(fqrn, "results.0.name", "multi1-3.7-20220310.233859-2.jar"),
+					resource.TestCheckResourceAttr(fqrn, "results.0.path", "org/jfrog/test/multi1/3.7-SNAPSHOT/multi1-3.7-20220310.233859-2.jar"),
+					resource.TestMatchResourceAttr(fqrn, "results.0.sha256", regexp.MustCompile("^[0-9a-f]{64}$")),
+					resource.TestMatchResourceAttr(fqrn, "results.0.download_uri", regexp.MustCompile(".*/artifactory/"+repoName+"/org/jfrog/test/.*")),
+					resource.TestCheckResourceAttrSet(fqrn, "aql"),
+					resource.TestCheckResourceAttr(rawFqrn, "results.#", "2"),
+				),
+			},
+		},
+	})
+}
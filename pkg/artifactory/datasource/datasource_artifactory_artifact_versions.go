@@ -0,0 +1,325 @@
+package datasource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/repolayout"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository"
+)
+
+const (
+	LatestVersionSearchEndpoint = "artifactory/api/search/latestVersion"
+	VersionsSearchEndpoint      = "artifactory/api/search/versions"
+)
+
+// versionTokenParams maps the layout tokens to the parameters of the version search APIs.
+var versionTokenParams = map[string]string{
+	repolayout.TokenOrg:     "g",
+	repolayout.TokenOrgPath: "g",
+	repolayout.TokenModule:  "a",
+	repolayout.TokenBaseRev: "v",
+}
+
+type ArtifactVersion struct {
+	Version     string `json:"version"`
+	Integration bool   `json:"integration"`
+}
+
+type ArtifactVersionsResult struct {
+	Results []ArtifactVersion `json:"results"`
+}
+
+var versionPartRegexp = regexp.MustCompile(`[0-9]+|[^0-9.\-+_]+`)
+
+// CompareVersions compares two versions part by part, numeric parts numerically and other parts lexically, e.g.
+// `1.2` < `1.10` < `1.10.1`. It returns -1, 0 or 1.
+func CompareVersions(a, b string) int {
+	aParts := versionPartRegexp.FindAllString(a, -1)
+	bParts := versionPartRegexp.FindAllString(b, -1)
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		aNumber, aErr := strconv.ParseUint(aParts[i], 10, 64)
+		bNumber, bErr := strconv.ParseUint(bParts[i], 10, 64)
+		switch {
+		case aErr == nil && bErr == nil:
+			if aNumber != bNumber {
+				if aNumber < bNumber {
+					return -1
+				}
+				return 1
+			}
+		case aErr == nil:
+			// a number is more recent than a qualifier, e.g. `1.0.1` > `1.0-rc1`
+			return 1
+		case bErr == nil:
+			return -1
+		default:
+			if c := strings.Compare(aParts[i], bParts[i]); c != 0 {
+				return c
+			}
+		}
+	}
+
+	// an extra number is more recent, an extra qualifier is a pre-release, e.g. `1.0-rc1` < `1.0` < `1.0.1`
+	switch {
+	case len(aParts) < len(bParts):
+		if _, err := strconv.ParseUint(bParts[len(aParts)], 10, 64); err == nil {
+			return -1
+		}
+		return 1
+	case len(aParts) > len(bParts):
+		if _, err := strconv.ParseUint(aParts[len(bParts)], 10, 64); err == nil {
+			return 1
+		}
+		return -1
+	}
+	return strings.Compare(a, b)
+}
+
+var versionTokenKeysValidator = validation.MapKeyMatch(
+	regexp.MustCompile(fmt.Sprintf("^(%s|%s|%s|%s)$", repolayout.TokenOrg, repolayout.TokenOrgPath, repolayout.TokenModule, repolayout.TokenBaseRev)),
+	"supported tokens are org, orgPath, module and baseRev",
+)
+
+// validateVersionTokens is a schema.SchemaValidateDiagFunc for `tokens`. org and orgPath are both matched with the group
+// ID, so they conflict.
+func validateVersionTokens(value interface{}, path cty.Path) diag.Diagnostics {
+	diags := versionTokenKeysValidator(value, path)
+	tokens, ok := value.(map[string]interface{})
+	if !ok {
+		return diags
+	}
+
+	_, hasOrg := tokens[repolayout.TokenOrg]
+	_, hasOrgPath := tokens[repolayout.TokenOrgPath]
+	if hasOrg && hasOrgPath {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Conflicting layout tokens",
+			Detail:        "org and orgPath are both matched with the group ID, only one of them can be set",
+			AttributePath: path,
+		})
+	}
+	return diags
+}
+
+func ArtifactoryArtifactVersions() *schema.Resource {
+	coordinateKeys := []string{"group_id", "artifact_id", "tokens"}
+
+	return &schema.Resource{
+		ReadContext: dataSourceArtifactVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  coordinateKeys,
+				ConflictsWith: []string{"tokens"},
+				Description:   "Group ID of the artifact, matched with the `[org]` or `[orgPath]` token of the layouts, e.g. `com.example`.",
+			},
+			"artifact_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  coordinateKeys,
+				ConflictsWith: []string{"tokens"},
+				Description:   "Artifact ID of the artifact, matched with the `[module]` token of the layouts, e.g. `lib`.",
+			},
+			"version": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"tokens"},
+				Description:   "Version pattern the versions match, matched with the `[baseRev]` token of the layouts, e.g. `1.*`.",
+			},
+			"tokens": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validateVersionTokens,
+				Description:      "Values of the layout tokens of the artifact, instead of its coordinates, e.g. `{ orgPath = \"com/example\", module = \"lib\" }`. Supported tokens: `org`, `orgPath`, `module`, `baseRev`. `org` conflicts with `orgPath`.",
+			},
+			"repositories": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: repository.RepoKeyValidator},
+				Description: "Keys of the repositories to search in. The repositories must have a layout, set with `repo_layout_ref`.",
+			},
+			"release_only": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"snapshot"},
+				Description:   "Only return the release versions, not the integration (snapshot) ones. Default to `false`.",
+			},
+			"snapshot": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only return the integration (snapshot) versions. Default to `false`.",
+			},
+			"include_remote": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Search the remote repositories in their remote URL as well, not only their cache. Default to `false`.",
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Versions found, sorted from the oldest to the latest.",
+			},
+			"latest": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Latest version found, one of `versions`. Empty if no version is found.",
+			},
+		},
+		Description: "Searches the versions of an artifact in repositories with a layout, and returns the latest one, e.g. to pin " +
+			"the latest release of a library.",
+	}
+}
+
+func getVersionSearchParams(d *schema.ResourceData) map[string]string {
+	params := map[string]string{}
+	if tokens := d.Get("tokens").(map[string]interface{}); len(tokens) > 0 {
+		for token, value := range tokens {
+			param := value.(string)
+			if token == repolayout.TokenOrgPath {
+				param = strings.ReplaceAll(param, "/", ".")
+			}
+			params[versionTokenParams[token]] = param
+		}
+		return params
+	}
+
+	for key, param := range map[string]string{"group_id": "g", "artifact_id": "a", "version": "v"} {
+		if value := d.Get(key).(string); value != "" {
+			params[param] = value
+		}
+	}
+	return params
+}
+
+// checkRepoLayouts returns an error if a repository has no layout, the version searches ignore it. Reading a
+// repository requires more permissions than searching it, so a repository the user can't read is only reported as a
+// warning.
+func checkRepoLayouts(client *resty.Client, repositories []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, repoKey := range repositories {
+		repo := struct {
+			RepoLayoutRef string `json:"repoLayoutRef"`
+		}{}
+		resp, err := client.R().
+			SetResult(&repo).
+			SetPathParam("key", repoKey).
+			Get(repository.RepositoriesEndpoint)
+		if err != nil {
+			if resp != nil && (resp.StatusCode() == http.StatusUnauthorized || resp.StatusCode() == http.StatusForbidden) {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Repository layout can't be verified",
+					Detail:   fmt.Sprintf("failed to read repository %s, the versions are not found if it has no layout: %s", repoKey, err),
+				})
+				continue
+			}
+			return append(diags, diag.Errorf("failed to read repository %s: %s", repoKey, err)...)
+		}
+		if repo.RepoLayoutRef == "" {
+			return append(diags, diag.Errorf("repository %s has no layout, set its repo_layout_ref", repoKey)...)
+		}
+	}
+	return diags
+}
+
+func dataSourceArtifactVersionsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	data := &utilsdk.ResourceData{ResourceData: d}
+	client := m.(utilsdk.ProvderMetadata).Client
+	repositories := utilsdk.CastToStringArr(d.Get("repositories").([]interface{}))
+	releaseOnly := data.GetBool("release_only", false)
+	snapshot := data.GetBool("snapshot", false)
+
+	diags := checkRepoLayouts(client, repositories)
+	if diags.HasError() {
+		return diags
+	}
+
+	params := getVersionSearchParams(d)
+	params["repos"] = strings.Join(repositories, ",")
+	params["remote"] = "0"
+	if data.GetBool("include_remote", false) {
+		params["remote"] = "1"
+	}
+
+	// Artifactory answers 404 when no version is found
+	result := ArtifactVersionsResult{}
+	resp, err := client.R().
+		SetQueryParams(params).
+		SetResult(&result).
+		Get(VersionsSearchEndpoint)
+	if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+		return append(diags, diag.Errorf("failed to search the versions of %v: %s", params, err)...)
+	}
+
+	var versions []string
+	for _, version := range result.Results {
+		if (releaseOnly && version.Integration) || (snapshot && !version.Integration) {
+			continue
+		}
+		versions = append(versions, version.Version)
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return CompareVersions(versions[i], versions[j]) < 0
+	})
+
+	latest := ""
+	if len(versions) > 0 {
+		latest = versions[len(versions)-1]
+	}
+	// the latest version of Artifactory takes the layouts into account, it's only used when no version is filtered out.
+	// It may be a unique snapshot version, e.g. `1.0-20230101.120000-1` for `1.0-SNAPSHOT`, so it's only used when it's
+	// one of the versions, to keep latest consistent with versions.
+	if !releaseOnly && !snapshot && len(versions) > 0 {
+		resp, err := client.R().
+			SetQueryParams(params).
+			Get(LatestVersionSearchEndpoint)
+		if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+			return append(diags, diag.Errorf("failed to search the latest version of %v: %s", params, err)...)
+		}
+		if err == nil {
+			artifactoryLatest := strings.TrimSpace(resp.String())
+			for _, version := range versions {
+				if version == artifactoryLatest {
+					latest = artifactoryLatest
+					break
+				}
+			}
+		}
+	}
+
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%v%t%t", params, releaseOnly, snapshot)))
+	d.SetId(hex.EncodeToString(hash[:]))
+
+	setValue := utilsdk.MkLens(d)
+	setValue("versions", versions)
+	errors := setValue("latest", latest)
+	if errors != nil && len(errors) > 0 {
+		return append(diags, diag.Errorf("failed to pack artifact versions %q", errors)...)
+	}
+
+	return diags
+}
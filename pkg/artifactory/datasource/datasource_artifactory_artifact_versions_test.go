@@ -0,0 +1,110 @@
+package datasource_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/datasource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareVersions(t *testing.T) {
+	for _, testCase := range []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.0", "1.0", 0},
+		{"1.2", "1.10", -1},
+		{"1.10", "1.10.1", -1},
+		{"2.0", "1.99", 1},
+		{"1.0-rc1", "1.0.1", -1},
+		{"1.0-alpha", "1.0-beta", -1},
+		{"1.0-rc1", "1.0", -1},
+		{"3.7-SNAPSHOT", "3.7", -1},
+	} {
+		assert.Equal(t, testCase.expected, datasource.CompareVersions(testCase.a, testCase.b), "%s <=> %s", testCase.a, testCase.b)
+		assert.Equal(t, -testCase.expected, datasource.CompareVersions(testCase.b, testCase.a), "%s <=> %s", testCase.b, testCase.a)
+	}
+}
+
+func TestArtifactoryArtifactVersions_tokens(t *testing.T) {
+	r := datasource.ArtifactoryArtifactVersions()
+
+	for _, testCase := range []struct {
+		tokens   map[string]interface{}
+		hasError bool
+	}{
+		{tokens: map[string]interface{}{"orgPath": "com/example", "module": "lib"}, hasError: false},
+		{tokens: map[string]interface{}{"org": "com.example", "baseRev": "1.*"}, hasError: false},
+		{tokens: map[string]interface{}{"org": "com.example", "orgPath": "com/example"}, hasError: true},
+		{tokens: map[string]interface{}{"classifier": "sources"}, hasError: true},
+	} {
+		diags := r.Validate(sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+			"tokens":       testCase.tokens,
+			"repositories": []interface{}{"libs-release-local"},
+		}))
+		assert.Equal(t, testCase.hasError, diags.HasError(), "tokens %v: %v", testCase.tokens, diags)
+	}
+}
+
+func TestAccDataSourceArtifactVersions(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("versions-", "data.artifactory_artifact_versions")
+	_, tokensFqrn, tokensName := testutil.MkNames("versions-tokens-", "data.artifactory_artifact_versions")
+	_, releaseFqrn, releaseName := testutil.MkNames("versions-release-", "data.artifactory_artifact_versions")
+	repoName := fmt.Sprintf("maven-local-%d", testutil.RandomInt())
+
+	config := fmt.Sprintf(`
+		data "artifactory_artifact_versions" "%s" {
+			group_id     = "org.jfrog.test"
+			artifact_id  = "multi1"
+			repositories = ["%s"]
+		}
+
+		data "artifactory_artifact_versions" "%s" {
+			tokens = {
+				orgPath = "org/jfrog/test"
+				module  = "multi1"
+			}
+			repositories = ["%s"]
+		}
+
+		data "artifactory_artifact_versions" "%s" {
+			group_id     = "org.jfrog.test"
+			artifact_id  = "multi1"
+			repositories = ["%s"]
+			release_only = true
+		}
+	`, name, repoName, tokensName, repoName, releaseName, repoName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.CreateRepo(t, repoName, "local", "maven", true, true)
+			uploadTwoArtifacts(t, repoName)
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			acctest.DeleteRepo(t, repoName)
+			return nil
+		},
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "versions.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "versions.0", "3.7-SNAPSHOT"),
+					resource.TestCheckResourceAttr(fqrn, "latest", "3.7-SNAPSHOT"),
+					resource.TestCheckResourceAttr(tokensFqrn, "versions.#", "1"),
+					resource.TestCheckResourceAttr(tokensFqrn, "versions.0", "3.7-SNAPSHOT"),
+					resource.TestCheckResourceAttr(releaseFqrn, "versions.#", "0"),
+					resource.TestCheckResourceAttr(releaseFqrn, "latest", ""),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,167 @@
+package datasource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository"
+)
+
+const ChecksumSearchEndpoint = "artifactory/api/search/checksum"
+
+type SearchResult struct {
+	Results []FileInfo `json:"results"`
+}
+
+var searchRepositoriesSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Optional:    true,
+	Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: repository.RepoKeyValidator},
+	Description: "Keys of the repositories to search in. Default to all the repositories.",
+}
+
+var searchResultsSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Computed:    true,
+	Description: "Files found, sorted by repository and path.",
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"repository":   {Type: schema.TypeString, Computed: true},
+			"path":         {Type: schema.TypeString, Computed: true},
+			"download_uri": {Type: schema.TypeString, Computed: true},
+			"size":         {Type: schema.TypeInt, Computed: true},
+			"sha1":         {Type: schema.TypeString, Computed: true},
+			"sha256":       {Type: schema.TypeString, Computed: true},
+		},
+	},
+}
+
+// search calls a search API of Artifactory with the info result detail, so the files are returned with their FileInfo.
+// Versions of Artifactory ignoring the result detail only return the storage API uri of the files, their FileInfo is
+// then read from it. Artifactory answers 404 when no file is found.
+func search(client *resty.Client, endpoint string, params map[string]string, repositories []string) ([]FileInfo, error) {
+	request := client.R().
+		SetHeader("X-Result-Detail", "info").
+		SetQueryParams(params)
+	if len(repositories) > 0 {
+		request.SetQueryParam("repos", strings.Join(repositories, ","))
+	}
+
+	result := SearchResult{}
+	resp, err := request.SetResult(&result).Get(endpoint)
+	if err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for i, fileInfo := range result.Results {
+		if fileInfo.Repo != "" || fileInfo.Uri == "" {
+			continue
+		}
+		if _, err := client.R().SetResult(&result.Results[i]).Get(fileInfo.Uri); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %s", fileInfo.Uri, err)
+		}
+	}
+
+	sort.Slice(result.Results, func(i, j int) bool {
+		if result.Results[i].Repo != result.Results[j].Repo {
+			return result.Results[i].Repo < result.Results[j].Repo
+		}
+		return result.Results[i].Path < result.Results[j].Path
+	})
+	return result.Results, nil
+}
+
+func packSearchResults(d *schema.ResourceData, id string, fileInfos []FileInfo) diag.Diagnostics {
+	results := make([]map[string]interface{}, 0, len(fileInfos))
+	for _, fileInfo := range fileInfos {
+		results = append(results, map[string]interface{}{
+			"repository":   fileInfo.Repo,
+			"path":         strings.TrimPrefix(fileInfo.Path, "/"),
+			"download_uri": fileInfo.DownloadUri,
+			"size":         fileInfo.Size,
+			"sha1":         fileInfo.Checksums.Sha1,
+			"sha256":       fileInfo.Checksums.Sha256,
+		})
+	}
+
+	hash := sha256.Sum256([]byte(id))
+	d.SetId(hex.EncodeToString(hash[:]))
+
+	setValue := utilsdk.MkLens(d)
+	errors := setValue("results", results)
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed to pack search results %q", errors)
+	}
+	return nil
+}
+
+func checksumRegex(length int) *regexp.Regexp {
+	return regexp.MustCompile(fmt.Sprintf("^[0-9a-fA-F]{%d}$", length))
+}
+
+func ArtifactoryChecksumSearch() *schema.Resource {
+	checksumKeys := []string{"sha256", "sha1", "md5"}
+
+	return &schema.Resource{
+		ReadContext: dataSourceChecksumSearchRead,
+
+		Schema: map[string]*schema.Schema{
+			"sha256": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     checksumKeys,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(checksumRegex(64), "must be a SHA256 checksum")),
+				Description:      "SHA256 checksum of the files to search.",
+			},
+			"sha1": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     checksumKeys,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(checksumRegex(40), "must be a SHA1 checksum")),
+				Description:      "SHA1 checksum of the files to search.",
+			},
+			"md5": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     checksumKeys,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(checksumRegex(32), "must be a MD5 checksum")),
+				Description:      "MD5 checksum of the files to search.",
+			},
+			"repositories": searchRepositoriesSchema,
+			"results":      searchResultsSchema,
+		},
+		Description: "Searches the files with a checksum, e.g. to find the repositories already holding a binary.",
+	}
+}
+
+func dataSourceChecksumSearchRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	params := map[string]string{}
+	for _, key := range []string{"sha256", "sha1", "md5"} {
+		if value := d.Get(key).(string); value != "" {
+			params[key] = strings.ToLower(value)
+		}
+	}
+	repositories := utilsdk.CastToStringArr(d.Get("repositories").([]interface{}))
+
+	fileInfos, err := search(m.(utilsdk.ProvderMetadata).Client, ChecksumSearchEndpoint, params, repositories)
+	if err != nil {
+		return diag.Errorf("failed to search the files with checksum %v: %s", params, err)
+	}
+
+	return packSearchResults(d, fmt.Sprintf("%v%v", params, repositories), fileInfos)
+}
@@ -0,0 +1,116 @@
+package datasource_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/datasource"
+	"github.com/jfrog/terraform-provider-shared/client"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecksumSearch_uriOnlyResults(t *testing.T) {
+	const checksum = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/artifactory/api/search/checksum":
+			// the result detail is ignored, only the storage API uri of the files is returned
+			fmt.Fprintf(w, `{"results":[{"uri":"%[1]s/artifactory/api/storage/libs-release/org/b.jar"},{"uri":"%[1]s/artifactory/api/storage/libs-release/org/a.jar"}]}`, server.URL)
+		case strings.HasPrefix(r.URL.Path, "/artifactory/api/storage/libs-release/"):
+			path := strings.TrimPrefix(r.URL.Path, "/artifactory/api/storage/libs-release")
+			fmt.Fprintf(w, `{"repo":"libs-release","path":"%[2]s","downloadUri":"%[1]s/artifactory/libs-release%[2]s","size":"42","checksums":{"sha1":"0123456789abcdef0123456789abcdef01234567","sha256":"%[3]s"}}`, server.URL, path, checksum)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	restyClient, err := client.Build(server.URL, "terraform-provider-artifactory/test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	restyClient, err = client.AddAuth(restyClient, "", "test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ds := datasource.ArtifactoryChecksumSearch()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{"sha256": checksum})
+	diags := ds.ReadContext(context.Background(), d, utilsdk.ProvderMetadata{Client: restyClient})
+	assert.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, 2, d.Get("results.#"))
+	for i, path := range []string{"org/a.jar", "org/b.jar"} {
+		assert.Equal(t, "libs-release", d.Get(fmt.Sprintf("results.%d.repository", i)))
+		assert.Equal(t, path, d.Get(fmt.Sprintf("results.%d.path", i)))
+		assert.Equal(t, server.URL+"/artifactory/libs-release/"+path, d.Get(fmt.Sprintf("results.%d.download_uri", i)))
+		assert.Equal(t, 42, d.Get(fmt.Sprintf("results.%d.size", i)))
+		assert.Equal(t, checksum, d.Get(fmt.Sprintf("results.%d.sha256", i)))
+	}
+}
+
+func TestAccDataSourceChecksumSearch(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("checksum-", "data.artifactory_checksum_search")
+	_, otherFqrn, otherName := testutil.MkNames("checksum-other-", "data.artifactory_checksum_search")
+	repoName := fmt.Sprintf("maven-local-%d", testutil.RandomInt())
+
+	content, err := os.ReadFile("../../../samples/multi1-3.7-20220310.233859-2.jar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := sha256.Sum256(content)
+	checksum := hex.EncodeToString(hash[:])
+
+	config := fmt.Sprintf(`
+		data "artifactory_checksum_search" "%s" {
+			sha256       = "%s"
+			repositories = ["%s"]
+		}
+
+		data "artifactory_checksum_search" "%s" {
+			sha256       = "%s"
+			repositories = ["example-repo-local"]
+		}
+	`, name, checksum, repoName, otherName, checksum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.CreateRepo(t, repoName, "local", "maven", true, true)
+			uploadTwoArtifacts(t, repoName)
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			acctest.DeleteRepo(t, repoName)
+			return nil
+		},
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "results.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "results.0.repository", repoName),
+					resource.TestCheckResourceAttr(fqrn, "results.0.path", "org/jfrog/test/multi1/3.7-SNAPSHOT/multi1-3.7-20220310.233859-2.jar"),
+					resource.TestCheckResourceAttr(fqrn, "results.0.sha256", checksum),
+					resource.TestCheckResourceAttrSet(fqrn, "results.0.download_uri"),
+					resource.TestCheckResourceAttr(otherFqrn, "results.#", "0"),
+				),
+			},
+		},
+	})
+}
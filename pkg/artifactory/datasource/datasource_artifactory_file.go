@@ -26,6 +26,13 @@ type FileInfo struct {
 	Checksums         Checksums `json:"checksums,omitempty"`
 	OriginalChecksums Checksums `json:"originalChecksums,omitempty"`
 	Uri               string    `json:"uri,omitempty"`
+	Children          []Child   `json:"children,omitempty"`
+}
+
+// Child is an item of a folder, only set in the FileInfo of folders.
+type Child struct {
+	Uri    string `json:"uri"`
+	Folder bool   `json:"folder"`
 }
 
 type Checksums struct {
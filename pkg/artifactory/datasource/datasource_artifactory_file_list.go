@@ -0,0 +1,233 @@
+package datasource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/antpath"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository"
+)
+
+type FileListItem struct {
+	Uri          string `json:"uri"`
+	Size         int64  `json:"size"`
+	LastModified string `json:"lastModified"`
+	Folder       bool   `json:"folder"`
+	Sha1         string `json:"sha1"`
+	Sha2         string `json:"sha2"`
+}
+
+type FileList struct {
+	Uri     string         `json:"uri"`
+	Created string         `json:"created"`
+	Files   []FileListItem `json:"files"`
+}
+
+// validatePattern validates an Ant-style pattern of include_patterns or exclude_patterns.
+func validatePattern(value interface{}, key string) ([]string, []error) {
+	pattern := value.(string)
+	if pattern == "" {
+		return nil, []error{fmt.Errorf("%s must not be empty", key)}
+	}
+	warnings, err := antpath.Validate(pattern)
+	if err != nil {
+		return warnings, []error{fmt.Errorf("%s is not a valid pattern: %s", key, err)}
+	}
+	return warnings, nil
+}
+
+// RelativeFileUri returns the path of an item of the folder relative to the folder, without a leading `/`, whether the
+// uri of the item includes the path of the folder or not.
+func RelativeFileUri(uri, folder string, includeRootPath bool) string {
+	uri = strings.TrimPrefix(uri, "/")
+	if includeRootPath && folder != "" {
+		uri = strings.TrimPrefix(uri, folder+"/")
+	}
+	return uri
+}
+
+// MatchFilePatterns returns true if the path matches one of the Ant-style include patterns, or there are none, and none
+// of the exclude patterns. A pattern without a `/` is matched with the name of the file, otherwise with its whole path,
+// relative to the folder listed.
+func MatchFilePatterns(filePath string, includePatterns, excludePatterns []string) bool {
+	filePath = strings.TrimPrefix(filePath, "/")
+	match := func(pattern string) bool {
+		if !strings.Contains(pattern, antpath.PathSeparator) {
+			return antpath.Match(pattern, path.Base(filePath))
+		}
+		return antpath.Match(pattern, filePath)
+	}
+
+	for _, pattern := range excludePatterns {
+		if match(pattern) {
+			return false
+		}
+	}
+	if len(includePatterns) == 0 {
+		return true
+	}
+	for _, pattern := range includePatterns {
+		if match(pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+func ArtifactoryFileList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFileListRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: repository.RepoKeyValidator,
+			},
+			"folder": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Path of the folder in the repository. Default to the root of the repository.",
+			},
+			"deep": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "List the items of the sub-folders as well. Default to `false`.",
+			},
+			"depth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Depth of the sub-folders listed when `deep` is set. Default to all the sub-folders.",
+			},
+			"list_folders": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "List the folders as well as the files. Default to `false`.",
+			},
+			"include_root_path": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Include the path of the folder in the uri of the items. Default to `false`.",
+			},
+			"include_patterns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validatePattern},
+				Description: "Ant-style patterns the items match, with `**`, `*` and `?` wildcards. A pattern without a `/` is matched with the name of the items, otherwise with their path relative to `folder`, whether `include_root_path` is set or not. Default to all the items.",
+			},
+			"exclude_patterns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validatePattern},
+				Description: "Patterns the items don't match, with the same syntax as `include_patterns`.",
+			},
+			"uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"files": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Items of the folder, in the order returned by Artifactory.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uri":           {Type: schema.TypeString, Computed: true},
+						"size":          {Type: schema.TypeInt, Computed: true},
+						"last_modified": {Type: schema.TypeString, Computed: true},
+						"folder":        {Type: schema.TypeBool, Computed: true},
+						"sha1":          {Type: schema.TypeString, Computed: true},
+						"sha256":        {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+		Description: "Lists the items of a folder of a repository, e.g. to iterate over the artifacts of a release.",
+	}
+}
+
+func dataSourceFileListRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	data := &utilsdk.ResourceData{ResourceData: d}
+	repo := data.GetString("repository", false)
+	folder := strings.Trim(data.GetString("folder", false), "/")
+	includePatterns := utilsdk.CastToStringArr(d.Get("include_patterns").([]interface{}))
+	excludePatterns := utilsdk.CastToStringArr(d.Get("exclude_patterns").([]interface{}))
+
+	params := map[string]string{
+		"list":            "",
+		"deep":            "0",
+		"listFolders":     "0",
+		"includeRootPath": "0",
+	}
+	if data.GetBool("deep", false) {
+		params["deep"] = "1"
+		if depth := data.GetInt("depth", false); depth > 0 {
+			params["depth"] = fmt.Sprintf("%d", depth)
+		}
+	}
+	if data.GetBool("list_folders", false) {
+		params["listFolders"] = "1"
+	}
+	includeRootPath := data.GetBool("include_root_path", false)
+	if includeRootPath {
+		params["includeRootPath"] = "1"
+	}
+
+	list := FileList{}
+	resp, err := m.(utilsdk.ProvderMetadata).Client.R().
+		SetQueryParams(params).
+		SetResult(&list).
+		Get(fmt.Sprintf("artifactory/api/storage/%s/%s", repo, folder))
+	if err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return diag.Errorf("folder %s not found in repository %s", folder, repo)
+		}
+		return diag.Errorf("failed to list the items of %s in repository %s: %s", folder, repo, err)
+	}
+
+	files := make([]map[string]interface{}, 0, len(list.Files))
+	for _, file := range list.Files {
+		if !MatchFilePatterns(RelativeFileUri(file.Uri, folder, includeRootPath), includePatterns, excludePatterns) {
+			continue
+		}
+		files = append(files, map[string]interface{}{
+			"uri":           file.Uri,
+			"size":          file.Size,
+			"last_modified": file.LastModified,
+			"folder":        file.Folder,
+			"sha1":          file.Sha1,
+			"sha256":        file.Sha2,
+		})
+	}
+
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s%v%v%v", repo, folder, params, includePatterns, excludePatterns)))
+	d.SetId(hex.EncodeToString(hash[:]))
+
+	setValue := utilsdk.MkLens(d)
+	setValue("uri", list.Uri)
+	setValue("created", list.Created)
+	errors := setValue("files", files)
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed to pack file list %q", errors)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package datasource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/datasource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchFilePatterns(t *testing.T) {
+	for _, testCase := range []struct {
+		uri      string
+		include  []string
+		exclude  []string
+		expected bool
+	}{
+		{"/lib/1.0/lib-1.0.jar", nil, nil, true},
+		{"/lib/1.0/lib-1.0.jar", []string{"*.jar"}, nil, true},
+		{"/lib/1.0/lib-1.0.pom", []string{"*.jar"}, nil, false},
+		{"/lib/1.0/lib-1.0.jar", []string{"lib/*/*.jar"}, nil, true},
+		{"/lib/1.0/lib-1.0.jar", []string{"*/*.jar"}, nil, false},
+		{"/lib/1.0/lib-1.0-sources.jar", []string{"*.jar"}, []string{"*-sources.jar"}, false},
+		{"/lib/1.0/lib-1.0.pom", nil, []string{"*.jar"}, true},
+		{"/lib/1.0/lib-1.0.jar", []string{"**/*.jar"}, nil, true},
+		{"/lib/1.0/lib-1.0.jar", []string{"lib/**"}, nil, true},
+		{"/lib/1.0/lib-1.0.jar", []string{"lib/?.0/*"}, nil, true},
+		{"/lib/1.0/lib-1.0.jar", []string{"lib/**"}, []string{"**/1.0/**"}, false},
+		{"/lib/1.0/lib-1.0.jar", []string{"lib-1.[0-9].jar"}, nil, false},
+	} {
+		assert.Equal(t, testCase.expected, datasource.MatchFilePatterns(testCase.uri, testCase.include, testCase.exclude), "%s %v %v", testCase.uri, testCase.include, testCase.exclude)
+	}
+}
+
+func TestRelativeFileUri(t *testing.T) {
+	for _, testCase := range []struct {
+		uri             string
+		folder          string
+		includeRootPath bool
+		expected        string
+	}{
+		{"/1.0/lib-1.0.jar", "org/lib", false, "1.0/lib-1.0.jar"},
+		{"/org/lib/1.0/lib-1.0.jar", "org/lib", true, "1.0/lib-1.0.jar"},
+		{"/org/lib/1.0/lib-1.0.jar", "", true, "org/lib/1.0/lib-1.0.jar"},
+		{"/org/lib/1.0/lib-1.0.jar", "", false, "org/lib/1.0/lib-1.0.jar"},
+	} {
+		actual := datasource.RelativeFileUri(testCase.uri, testCase.folder, testCase.includeRootPath)
+		assert.Equal(t, testCase.expected, actual, "%s %s %t", testCase.uri, testCase.folder, testCase.includeRootPath)
+		assert.True(t, datasource.MatchFilePatterns(actual, []string{"1.0/*.jar", "org/lib/1.0/*.jar"}, nil))
+	}
+}
+
+func TestAccDataSourceFileList(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("file-list-", "data.artifactory_file_list")
+	_, deepFqrn, deepName := testutil.MkNames("file-list-deep-", "data.artifactory_file_list")
+	repoName := fmt.Sprintf("maven-local-%d", testutil.RandomInt())
+
+	config := fmt.Sprintf(`
+		data "artifactory_file_list" "%s" {
+			repository       = "%s"
+			folder           = "org/jfrog/test/multi1/3.7-SNAPSHOT"
+			include_patterns = ["*.jar"]
+			exclude_patterns = ["*-1.jar"]
+		}
+
+		data "artifactory_file_list" "%s" {
+			repository        = "%s"
+			folder            = "org/jfrog"
+			deep              = true
+			list_folders      = true
+			include_root_path = true
+		}
+	`, name, repoName, deepName, repoName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.CreateRepo(t, repoName, "local", "maven", true, true)
+			uploadTwoArtifacts(t, repoName)
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			acctest.DeleteRepo(t, repoName)
+			return nil
+		},
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "files.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "files.0.uri", "/multi1-3.7-20220310.233859-2.jar"),
+					resource.TestCheckResourceAttr(fqrn, "files.0.folder", "false"),
+					resource.TestCheckResourceAttrSet(fqrn, "files.0.sha1"),
+					resource.TestCheckResourceAttrSet(fqrn, "files.0.sha256"),
+					resource.TestCheckResourceAttrSet(fqrn, "files.0.size"),
+					resource.TestCheckResourceAttrSet(fqrn, "files.0.last_modified"),
+					resource.TestCheckTypeSetElemNestedAttrs(deepFqrn, "files.*", map[string]string{
+						"uri":    "/org/jfrog/test/multi1",
+						"folder": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(deepFqrn, "files.*", map[string]string{
+						"uri":    "/org/jfrog/test/multi1/3.7-SNAPSHOT/multi1-3.7-20220310.233748-1.jar",
+						"folder": "false",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -2,7 +2,13 @@ package datasource
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
@@ -68,10 +74,102 @@ func ArtifactoryFileInfo() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"include_properties": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Read the properties of the item into `properties`. Default to `false`.",
+			},
+			"include_stats": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Read the download statistics of the file into `download_stats`. Default to `false`.",
+			},
+			"include_folder_info": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Read the children of the folder into `folder_info`. Default to `false`.",
+			},
+			"properties": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Properties of the item, sorted by name. Only set with `include_properties`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {Type: schema.TypeString, Computed: true},
+						"values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"download_stats": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Download statistics of the file. Only set with `include_stats`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"download_count":            {Type: schema.TypeInt, Computed: true},
+						"last_downloaded":           {Type: schema.TypeString, Computed: true},
+						"last_downloaded_by":        {Type: schema.TypeString, Computed: true},
+						"remote_download_count":     {Type: schema.TypeInt, Computed: true},
+						"remote_last_downloaded":    {Type: schema.TypeString, Computed: true},
+						"remote_last_downloaded_by": {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+			"folder_info": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Folder information of the item. Only set with `include_folder_info`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"folder": {Type: schema.TypeBool, Computed: true},
+						"children": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"uri":    {Type: schema.TypeString, Computed: true},
+									"folder": {Type: schema.TypeBool, Computed: true},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+type ItemProperties struct {
+	Uri        string              `json:"uri"`
+	Properties map[string][]string `json:"properties"`
+}
+
+// DownloadStats are the download statistics of a file, its times are in milliseconds since the epoch.
+type DownloadStats struct {
+	Uri                    string `json:"uri"`
+	DownloadCount          int    `json:"downloadCount"`
+	LastDownloaded         int64  `json:"lastDownloaded"`
+	LastDownloadedBy       string `json:"lastDownloadedBy"`
+	RemoteDownloadCount    int    `json:"remoteDownloadCount"`
+	RemoteLastDownloaded   int64  `json:"remoteLastDownloaded"`
+	RemoteLastDownloadedBy string `json:"remoteLastDownloadedBy"`
+}
+
+// FormatStatsTime returns the RFC 3339 format of a time of the download statistics, empty if it's never happened.
+func FormatStatsTime(millis int64) string {
+	if millis <= 0 {
+		return ""
+	}
+	return time.UnixMilli(millis).UTC().Format(time.RFC3339)
+}
+
 func dataSourceFileInfoRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	repo := d.Get("repository").(string)
 	path := d.Get("path").(string)
@@ -88,7 +186,88 @@ func dataSourceFileInfoRead(_ context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
-	return packFileInfo(fileInfo, d)
+	if diags := packFileInfo(fileInfo, d); diags.HasError() {
+		return diags
+	}
+	return packFileInfoDetails(m.(utilsdk.ProvderMetadata).Client, repo, path, fileInfo, d)
+}
+
+// packFileInfoDetails packs the optional blocks of the item, only calling the APIs of the blocks asked for.
+func packFileInfoDetails(client *resty.Client, repo, path string, fileInfo FileInfo, d *schema.ResourceData) diag.Diagnostics {
+	storagePath := fmt.Sprintf("artifactory/api/storage/%s/%s", repo, strings.TrimPrefix(path, "/"))
+	// only folders have children, an empty folder has an empty list of children
+	isFolder := fileInfo.Children != nil
+	setValue := utilsdk.MkLens(d)
+
+	properties := []map[string]interface{}{}
+	if d.Get("include_properties").(bool) {
+		// Artifactory answers 404 when the item has no properties
+		itemProperties := ItemProperties{}
+		resp, err := client.R().
+			SetQueryParam("properties", "").
+			SetResult(&itemProperties).
+			Get(storagePath)
+		if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+			return diag.Errorf("failed to read the properties of %s in repository %s: %s", path, repo, err)
+		}
+
+		names := make([]string, 0, len(itemProperties.Properties))
+		for name := range itemProperties.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			properties = append(properties, map[string]interface{}{
+				"name":   name,
+				"values": itemProperties.Properties[name],
+			})
+		}
+	}
+	setValue("properties", properties)
+
+	downloadStats := []map[string]interface{}{}
+	if d.Get("include_stats").(bool) && !isFolder {
+		stats := DownloadStats{}
+		_, err := client.R().
+			SetQueryParam("stats", "").
+			SetResult(&stats).
+			Get(storagePath)
+		if err != nil {
+			return diag.Errorf("failed to read the download statistics of %s in repository %s: %s", path, repo, err)
+		}
+
+		downloadStats = append(downloadStats, map[string]interface{}{
+			"download_count":            stats.DownloadCount,
+			"last_downloaded":           FormatStatsTime(stats.LastDownloaded),
+			"last_downloaded_by":        stats.LastDownloadedBy,
+			"remote_download_count":     stats.RemoteDownloadCount,
+			"remote_last_downloaded":    FormatStatsTime(stats.RemoteLastDownloaded),
+			"remote_last_downloaded_by": stats.RemoteLastDownloadedBy,
+		})
+	}
+	setValue("download_stats", downloadStats)
+
+	folderInfo := []map[string]interface{}{}
+	if d.Get("include_folder_info").(bool) {
+		children := make([]map[string]interface{}, 0, len(fileInfo.Children))
+		for _, child := range fileInfo.Children {
+			children = append(children, map[string]interface{}{
+				"uri":    child.Uri,
+				"folder": child.Folder,
+			})
+		}
+		folderInfo = append(folderInfo, map[string]interface{}{
+			"folder":   isFolder,
+			"children": children,
+		})
+	}
+	errors := setValue("folder_info", folderInfo)
+
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed to pack fileInfo details %q", errors)
+	}
+
+	return nil
 }
 
 func packFileInfo(fileInfo FileInfo, d *schema.ResourceData) diag.Diagnostics {
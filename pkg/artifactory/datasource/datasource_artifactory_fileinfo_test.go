@@ -0,0 +1,83 @@
+package datasource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/datasource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatStatsTime(t *testing.T) {
+	assert.Equal(t, "", datasource.FormatStatsTime(0))
+	assert.Equal(t, "2022-03-10T23:37:48Z", datasource.FormatStatsTime(1646955468000))
+}
+
+func TestAccDataSourceFileInfo_details(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("fileinfo-", "data.artifactory_fileinfo")
+	_, folderFqrn, folderName := testutil.MkNames("fileinfo-folder-", "data.artifactory_fileinfo")
+	repoName := fmt.Sprintf("maven-local-%d", testutil.RandomInt())
+	const filePath = "org/jfrog/test/multi1/3.7-SNAPSHOT/multi1-3.7-20220310.233859-2.jar"
+
+	config := fmt.Sprintf(`
+		data "artifactory_fileinfo" "%s" {
+			repository         = "%s"
+			path               = "%s"
+			include_properties = true
+			include_stats      = true
+		}
+
+		data "artifactory_fileinfo" "%s" {
+			repository          = "%s"
+			path                = "org/jfrog/test/multi1"
+			include_folder_info = true
+		}
+	`, name, repoName, filePath, folderName, repoName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.CreateRepo(t, repoName, "local", "maven", true, true)
+			uploadTwoArtifacts(t, repoName)
+			_, err := acctest.GetTestResty(t).R().
+				SetQueryParam("properties", "release=ga").
+				Put(fmt.Sprintf("artifactory/api/storage/%s/%s", repoName, filePath))
+			if err != nil {
+				t.Fatal(err)
+			}
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			acctest.DeleteRepo(t, repoName)
+			return nil
+		},
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fqrn, "sha256"),
+					resource.TestCheckResourceAttr(fqrn, "properties.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "properties.0.name", "release"),
+					resource.TestCheckResourceAttr(fqrn, "properties.0.values.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "properties.0.values.0", "ga"),
+					resource.TestCheckResourceAttr(fqrn, "download_stats.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "download_stats.0.download_count", "0"),
+					resource.TestCheckResourceAttr(fqrn, "folder_info.#", "0"),
+					resource.TestCheckResourceAttr(folderFqrn, "properties.#", "0"),
+					resource.TestCheckResourceAttr(folderFqrn, "download_stats.#", "0"),
+					resource.TestCheckResourceAttr(folderFqrn, "folder_info.#", "1"),
+					resource.TestCheckResourceAttr(folderFqrn, "folder_info.0.folder", "true"),
+					resource.TestCheckTypeSetElemNestedAttrs(folderFqrn, "folder_info.0.children.*", map[string]string{
+						"uri":    "/3.7-SNAPSHOT",
+						"folder": "true",
+					}),
+					resource.TestCheckResourceAttrSet(folderFqrn, "last_modified"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,300 @@
+package datasource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/workerpool"
+)
+
+type fileDownload struct {
+	Repository string
+	Path       string
+	OutputPath string
+}
+
+// the client of the provider formats the errors of the responses as `\n<status> <method> <url>\n<body>`
+var transientStatusRegex = regexp.MustCompile(`^\s*(408|429|500|502|503|504) `)
+
+// IsTransientError returns true if the download failed on a response status worth retrying. The network errors are
+// already retried by the client of the provider.
+func IsTransientError(err error) bool {
+	return transientStatusRegex.MatchString(err.Error())
+}
+
+// FilesDigest returns the SHA256 digest of the repositories, paths and SHA256 checksums of the files.
+func FilesDigest(fileInfos []FileInfo) string {
+	lines := make([]string, 0, len(fileInfos))
+	for _, fileInfo := range fileInfos {
+		lines = append(lines, fmt.Sprintf("%s/%s %s\n", fileInfo.Repo, strings.TrimPrefix(fileInfo.Path, "/"), fileInfo.Checksums.Sha256))
+	}
+	sort.Strings(lines)
+
+	hash := sha256.New()
+	for _, line := range lines {
+		hash.Write([]byte(line))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func ArtifactoryFiles() *schema.Resource {
+	sourceKeys := []string{"file", "selector"}
+	// a selector without a criterion would download all the files of Artifactory
+	selectorCriteriaKeys := []string{"selector.0.repositories", "selector.0.path_pattern", "selector.0.name_pattern", "selector.0.properties"}
+
+	return &schema.Resource{
+		ReadContext: dataSourceFilesRead,
+
+		Schema: map[string]*schema.Schema{
+			"file": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: sourceKeys,
+				Description:  "Files to download.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: repository.RepoKeyValidator,
+						},
+						"path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "Path of the file in the repository.",
+						},
+						"output_path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "Local path the file is downloaded to.",
+						},
+					},
+				},
+			},
+			"selector": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: sourceKeys,
+				Description:  "AQL search of the files to download, each one to the path of the file under `output_dir`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repositories": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							AtLeastOneOf: selectorCriteriaKeys,
+							Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: repository.RepoKeyValidator},
+							Description:  "Keys of the repositories to search in. Default to all the repositories.",
+						},
+						"path_pattern": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							AtLeastOneOf: selectorCriteriaKeys,
+							Description:  "Pattern the path of the files matches, with `*` and `?` wildcards, e.g. `com/example/*`.",
+						},
+						"name_pattern": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							AtLeastOneOf: selectorCriteriaKeys,
+							Description:  "Pattern the name of the files matches, with `*` and `?` wildcards, e.g. `*.jar`.",
+						},
+						"properties": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							AtLeastOneOf: selectorCriteriaKeys,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							Description:  "Values of properties the files have, e.g. `{ release = \"ga\" }`.",
+						},
+						"output_dir": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "Local directory the files are downloaded to.",
+						},
+					},
+				},
+			},
+			"force_overwrite": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Download the files even if the local files have the same SHA256 checksums. Default to `false`.",
+			},
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(1, 32),
+				Description:  "Number of files downloaded in parallel. Default to `4`.",
+			},
+			"retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(0, 10),
+				Description:  "Number of retries of a download failing on a `408`, `429`, `500`, `502`, `503` or `504` status. Default to `3`.",
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Files downloaded, in the order of `file`, or sorted by repository and path with `selector`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository":   {Type: schema.TypeString, Computed: true},
+						"path":         {Type: schema.TypeString, Computed: true},
+						"output_path":  {Type: schema.TypeString, Computed: true},
+						"download_uri": {Type: schema.TypeString, Computed: true},
+						"size":         {Type: schema.TypeInt, Computed: true},
+						"sha256":       {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+			"digest": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA256 digest of the repositories, paths and SHA256 checksums of the files, to detect a change of any of them.",
+			},
+		},
+		Description: "Downloads several files in parallel, verifying their SHA256 checksums, e.g. to fetch all the artifacts of a " +
+			"release with a single data source.",
+	}
+}
+
+func unpackFileDownloads(d *schema.ResourceData, m interface{}) ([]fileDownload, error) {
+	var downloads []fileDownload
+	for _, raw := range d.Get("file").([]interface{}) {
+		file := raw.(map[string]interface{})
+		downloads = append(downloads, fileDownload{
+			Repository: file["repository"].(string),
+			Path:       strings.TrimPrefix(file["path"].(string), "/"),
+			OutputPath: file["output_path"].(string),
+		})
+	}
+
+	if selectors := d.Get("selector").([]interface{}); len(selectors) > 0 {
+		selector := selectors[0].(map[string]interface{})
+		properties := map[string]string{}
+		for name, value := range selector["properties"].(map[string]interface{}) {
+			properties[name] = value.(string)
+		}
+		aql, err := BuildAqlQuery(AqlQuery{
+			Repositories: utilsdk.CastToStringArr(selector["repositories"].([]interface{})),
+			PathPattern:  selector["path_pattern"].(string),
+			NamePattern:  selector["name_pattern"].(string),
+			Properties:   properties,
+			SortBy:       []string{"repo", "path", "name"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		client := m.(utilsdk.ProvderMetadata).Client
+		items, err := searchAql(client, aql)
+		if err != nil {
+			return nil, err
+		}
+
+		outputDir := selector["output_dir"].(string)
+		for _, item := range items {
+			fileInfo := item.FileInfo(client.BaseURL)
+			downloads = append(downloads, fileDownload{
+				Repository: fileInfo.Repo,
+				Path:       fileInfo.Path,
+				OutputPath: filepath.Join(outputDir, filepath.FromSlash(fileInfo.Path)),
+			})
+		}
+	}
+
+	outputPaths := map[string]fileDownload{}
+	for _, download := range downloads {
+		if other, ok := outputPaths[download.OutputPath]; ok {
+			return nil, fmt.Errorf("%s/%s and %s/%s are both downloaded to %s", other.Repository, other.Path, download.Repository, download.Path, download.OutputPath)
+		}
+		outputPaths[download.OutputPath] = download
+	}
+	return downloads, nil
+}
+
+// downloadWithRetries downloads the file with downloadUsingFileInfo, so it's skipped if the local file has the same
+// checksum, and retries it on transient errors with a linear backoff.
+func downloadWithRetries(ctx context.Context, download fileDownload, forceOverwrite bool, retries int, m interface{}) (FileInfo, error) {
+	for attempt := 0; ; attempt++ {
+		fileInfo, err := downloadUsingFileInfo(ctx, download.OutputPath, forceOverwrite, download.Repository, download.Path, m)
+		if err == nil || attempt >= retries || !IsTransientError(err) {
+			return fileInfo, err
+		}
+
+		tflog.Warn(ctx, "Retrying file download", map[string]interface{}{
+			"repository": download.Repository,
+			"path":       download.Path,
+			"attempt":    attempt + 1,
+			"error":      err.Error(),
+		})
+		select {
+		case <-ctx.Done():
+			return fileInfo, ctx.Err()
+		case <-time.After(time.Duration(attempt+1) * time.Second):
+		}
+	}
+}
+
+func dataSourceFilesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	data := &utilsdk.ResourceData{ResourceData: d}
+	forceOverwrite := data.GetBool("force_overwrite", false)
+	retries := data.GetInt("retries", false)
+
+	downloads, err := unpackFileDownloads(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	results, errs := workerpool.Run(downloads, data.GetInt("parallelism", false), func(download fileDownload) (FileInfo, error) {
+		fileInfo, err := downloadWithRetries(ctx, download, forceOverwrite, retries, m)
+		if err != nil {
+			return fileInfo, fmt.Errorf("failed to download %s in repository %s: %s", download.Path, download.Repository, err)
+		}
+		return fileInfo, nil
+	})
+	if err := workerpool.JoinFailures("download", errs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	packedResults := make([]map[string]interface{}, 0, len(results))
+	for i, fileInfo := range results {
+		packedResults = append(packedResults, map[string]interface{}{
+			"repository":   downloads[i].Repository,
+			"path":         downloads[i].Path,
+			"output_path":  downloads[i].OutputPath,
+			"download_uri": fileInfo.DownloadUri,
+			"size":         fileInfo.Size,
+			"sha256":       fileInfo.Checksums.Sha256,
+		})
+	}
+
+	digest := FilesDigest(results)
+	d.SetId(digest)
+
+	setValue := utilsdk.MkLens(d)
+	setValue("results", packedResults)
+	errors := setValue("digest", digest)
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed to pack files %q", errors)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package datasource_test
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"testing"
+
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/datasource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTransientError(t *testing.T) {
+	assert.True(t, datasource.IsTransientError(fmt.Errorf("\n503 GET https://example.jfrog.io/artifactory/api/storage/repo/file\nunavailable")))
+	assert.True(t, datasource.IsTransientError(fmt.Errorf("\n429 GET https://example.jfrog.io/artifactory/repo/file\n")))
+	assert.False(t, datasource.IsTransientError(&net.OpError{Op: "dial", Err: fmt.Errorf("connection reset")}))
+	assert.False(t, datasource.IsTransientError(fmt.Errorf("\n404 GET https://example.jfrog.io/artifactory/api/storage/repo/file\nnot found")))
+	assert.False(t, datasource.IsTransientError(fmt.Errorf("Checksums for file a and b do not match, expected c")))
+}
+
+func TestArtifactoryFiles_selectorCriteriaRequired(t *testing.T) {
+	r := datasource.ArtifactoryFiles()
+	selector := func(selector map[string]interface{}) *sdkterraform.ResourceConfig {
+		selector["output_dir"] = "downloads"
+		return sdkterraform.NewResourceConfigRaw(map[string]interface{}{"selector": []interface{}{selector}})
+	}
+
+	assert.True(t, r.Validate(selector(map[string]interface{}{})).HasError())
+	assert.False(t, r.Validate(selector(map[string]interface{}{"name_pattern": "*.jar"})).HasError())
+	assert.False(t, r.Validate(selector(map[string]interface{}{"repositories": []interface{}{"libs-release-local"}})).HasError())
+}
+
+func TestFilesDigest(t *testing.T) {
+	first := datasource.FileInfo{Repo: "repo", Path: "/a.jar", Checksums: datasource.Checksums{Sha256: "1"}}
+	second := datasource.FileInfo{Repo: "repo", Path: "b.jar", Checksums: datasource.Checksums{Sha256: "2"}}
+	changed := datasource.FileInfo{Repo: "repo", Path: "b.jar", Checksums: datasource.Checksums{Sha256: "3"}}
+
+	assert.Equal(t, datasource.FilesDigest([]datasource.FileInfo{first, second}), datasource.FilesDigest([]datasource.FileInfo{second, first}))
+	assert.NotEqual(t, datasource.FilesDigest([]datasource.FileInfo{first, second}), datasource.FilesDigest([]datasource.FileInfo{first, changed}))
+}
+
+func TestAccDataSourceFiles(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("files-", "data.artifactory_files")
+	_, selectorFqrn, selectorName := testutil.MkNames("files-selector-", "data.artifactory_files")
+	repoName := fmt.Sprintf("maven-local-%d", testutil.RandomInt())
+	outputDir := t.TempDir()
+	const folder = "org/jfrog/test/multi1/3.7-SNAPSHOT"
+
+	config := fmt.Sprintf(`
+		data "artifactory_files" "%[1]s" {
+			file {
+				repository  = "%[3]s"
+				path        = "%[4]s/multi1-3.7-20220310.233748-1.jar"
+				output_path = "%[5]s/files/older.jar"
+			}
+			file {
+				repository  = "%[3]s"
+				path        = "%[4]s/multi1-3.7-20220310.233859-2.jar"
+				output_path = "%[5]s/files/newer.jar"
+			}
+			parallelism = 2
+		}
+
+		data "artifactory_files" "%[2]s" {
+			selector {
+				repositories = ["%[3]s"]
+				name_pattern = "*.jar"
+				output_dir   = "%[5]s/selector"
+			}
+		}
+	`, name, selectorName, repoName, folder, outputDir)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.CreateRepo(t, repoName, "local", "maven", true, true)
+			uploadTwoArtifacts(t, repoName)
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			acctest.DeleteRepo(t, repoName)
+			return nil
+		},
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "results.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "results.0.output_path", filepath.Join(outputDir, "files", "older.jar")),
+					resource.TestCheckResourceAttrSet(fqrn, "results.0.sha256"),
+					resource.TestCheckResourceAttrSet(fqrn, "digest"),
+					resource.TestCheckResourceAttr(selectorFqrn, "results.#", "2"),
+					resource.TestCheckResourceAttr(selectorFqrn, "results.0.path", folder+"/multi1-3.7-20220310.233748-1.jar"),
+					resource.TestCheckResourceAttr(selectorFqrn, "results.1.output_path", filepath.Join(outputDir, "selector", folder, "multi1-3.7-20220310.233859-2.jar")),
+					resource.TestCheckResourceAttrPair(fqrn, "digest", selectorFqrn, "digest"),
+					func(_ *terraform.State) error {
+						for _, path := range []string{"files/older.jar", "files/newer.jar", "selector/" + folder + "/multi1-3.7-20220310.233748-1.jar"} {
+							if !datasource.FileExists(filepath.Join(outputDir, path)) {
+								return fmt.Errorf("%s not downloaded", path)
+							}
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,67 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+)
+
+const GavcSearchEndpoint = "artifactory/api/search/gavc"
+
+func ArtifactoryGavcSearch() *schema.Resource {
+	coordinateKeys := []string{"group_id", "artifact_id", "version", "classifier"}
+
+	return &schema.Resource{
+		ReadContext: dataSourceGavcSearchRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: coordinateKeys,
+				Description:  "Group ID of the artifacts, e.g. `org.example`. Supports `*` and `?` wildcards.",
+			},
+			"artifact_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: coordinateKeys,
+				Description:  "Artifact ID of the artifacts. Supports `*` and `?` wildcards.",
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: coordinateKeys,
+				Description:  "Version of the artifacts. Supports `*` and `?` wildcards.",
+			},
+			"classifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: coordinateKeys,
+				Description:  "Classifier of the artifacts, e.g. `sources`. Supports `*` and `?` wildcards.",
+			},
+			"repositories": searchRepositoriesSchema,
+			"results":      searchResultsSchema,
+		},
+		Description: "Searches the Maven artifacts by coordinates (GAVC), in the local and remote repositories with a Maven layout.",
+	}
+}
+
+func dataSourceGavcSearchRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	params := map[string]string{}
+	for key, param := range map[string]string{"group_id": "g", "artifact_id": "a", "version": "v", "classifier": "c"} {
+		if value := d.Get(key).(string); value != "" {
+			params[param] = value
+		}
+	}
+	repositories := utilsdk.CastToStringArr(d.Get("repositories").([]interface{}))
+
+	fileInfos, err := search(m.(utilsdk.ProvderMetadata).Client, GavcSearchEndpoint, params, repositories)
+	if err != nil {
+		return diag.Errorf("failed to search the artifacts with coordinates %v: %s", params, err)
+	}
+
+	return packSearchResults(d, fmt.Sprintf("%v%v", params, repositories), fileInfos)
+}
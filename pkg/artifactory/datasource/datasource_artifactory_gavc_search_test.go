@@ -0,0 +1,48 @@
+package datasource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+)
+
+func TestAccDataSourceGavcSearch(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("gavc-", "data.artifactory_gavc_search")
+	repoName := fmt.Sprintf("maven-local-%d", testutil.RandomInt())
+
+	config := fmt.Sprintf(`
+		data "artifactory_gavc_search" "%s" {
+			group_id     = "org.jfrog.test"
+			artifact_id  = "multi1"
+			repositories = ["%s"]
+		}
+	`, name, repoName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.CreateRepo(t, repoName, "local", "maven", true, true)
+			uploadTwoArtifacts(t, repoName)
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			acctest.DeleteRepo(t, repoName)
+			return nil
+		},
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "results.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "results.0.repository", repoName),
+					resource.TestCheckResourceAttr(fqrn, "results.0.path", "org/jfrog/test/multi1/3.7-SNAPSHOT/multi1-3.7-20220310.233748-1.jar"),
+					resource.TestCheckResourceAttr(fqrn, "results.1.path", "org/jfrog/test/multi1/3.7-SNAPSHOT/multi1-3.7-20220310.233859-2.jar"),
+				),
+			},
+		},
+	})
+}
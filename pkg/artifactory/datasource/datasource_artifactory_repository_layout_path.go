@@ -0,0 +1,184 @@
+package datasource
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/repolayout"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/configuration"
+)
+
+func ArtifactoryRepositoryLayoutPath() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRepositoryLayoutPathRead,
+
+		Schema: map[string]*schema.Schema{
+			"layout_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"layout_name", "artifact_path_pattern"},
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				Description:      "Name of a built-in or custom layout defined in Artifactory, e.g. `maven-2-default`. The layout is read from the system configuration.",
+			},
+			"artifact_path_pattern": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				Description:      "Layout path pattern to compile instead of reading `layout_name` from Artifactory, e.g. `[orgPath]/[module]/[baseRev]/[module]-[baseRev].[ext]`.",
+			},
+			"folder_integration_revision_regexp": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"layout_name"},
+				Description:   "Folder integration revision regexp used with `artifact_path_pattern`. Default to `.*`.",
+			},
+			"file_integration_revision_regexp": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"layout_name"},
+				Description:   "File integration revision regexp used with `artifact_path_pattern`. Default to `.*`.",
+			},
+			"descriptor": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"layout_name"},
+				Description:  "Use the descriptor path pattern of `layout_name` instead of the artifact path pattern. The layout must have a distinctive descriptor path pattern. Default to `false`.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Artifact path to parse into `parsed_tokens`.",
+			},
+			"tokens": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Token values to build `built_path` from, e.g. `{ org = \"org.acme\", module = \"foo\", baseRev = \"1.0\", ext = \"jar\" }`. `org` and `orgPath` are interchangeable.",
+			},
+			"regex": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Regular expression the path pattern compiles to.",
+			},
+			"token_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tokens used in the path pattern, in order of appearance.",
+			},
+			"matches": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether `path` matches the path pattern.",
+			},
+			"parsed_tokens": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Token values parsed from `path`. Tokens of optional groups missing from the path are omitted.",
+			},
+			"built_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Path built from `tokens`.",
+			},
+		},
+	}
+}
+
+func dataSourceRepositoryLayoutPathRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	data := &utilsdk.ResourceData{ResourceData: d}
+
+	pathPattern := data.GetString("artifact_path_pattern", false)
+	folderItegRevRegexp := data.GetString("folder_integration_revision_regexp", false)
+	fileItegRevRegexp := data.GetString("file_integration_revision_regexp", false)
+
+	if layoutName := data.GetString("layout_name", false); layoutName != "" {
+		layouts := configuration.Layouts{}
+		_, err := m.(utilsdk.ProvderMetadata).Client.R().SetResult(&layouts).Get("artifactory/api/system/configuration")
+		if err != nil {
+			return diag.Errorf("failed to retrieve data from API: /artifactory/api/system/configuration during Read")
+		}
+
+		layout := configuration.FindConfigurationById[configuration.Layout](layouts.Layouts, layoutName)
+		if layout == nil {
+			return diag.Errorf("repository layout %s not found", layoutName)
+		}
+
+		pathPattern = layout.ArtifactPathPattern
+		if data.GetBool("descriptor", false) {
+			if !layout.DistinctiveDescriptorPathPattern {
+				return diag.Errorf("repository layout %s has no distinctive descriptor path pattern", layoutName)
+			}
+			pathPattern = layout.DescriptorPathPattern
+		}
+		folderItegRevRegexp = layout.FolderIntegrationRevisionRegExp
+		fileItegRevRegexp = layout.FileIntegrationRevisionRegExp
+	}
+
+	// Artifactory compiles the integration revision regexps as Java regular expressions, the ones Go can't compile are
+	// replaced so the layout can still be used
+	var diags diag.Diagnostics
+	for _, revision := range []struct {
+		name string
+		expr *string
+	}{
+		{name: "folder integration revision", expr: &folderItegRevRegexp},
+		{name: "file integration revision", expr: &fileItegRevRegexp},
+	} {
+		if goExpr, ok := repolayout.GoRevisionRegexp(*revision.expr); !ok {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Integration revision regexp replaced",
+				Detail: fmt.Sprintf("the %s regexp %q is not a valid Go regular expression, %q is used instead, so the "+
+					"paths are only parsed approximately", revision.name, *revision.expr, goExpr),
+			})
+			*revision.expr = goExpr
+		}
+	}
+
+	pattern, err := repolayout.Compile(pathPattern, folderItegRevRegexp, fileItegRevRegexp)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	setValue := utilsdk.MkLens(d)
+	setValue("regex", pattern.Regexp())
+	setValue("token_names", pattern.Tokens())
+
+	matches := false
+	var parsedTokens map[string]string
+	if path := data.GetString("path", false); path != "" {
+		parsedTokens, matches = pattern.Parse(path)
+	}
+	setValue("matches", matches)
+	setValue("parsed_tokens", parsedTokens)
+
+	builtPath := ""
+	if tokens := d.Get("tokens").(map[string]interface{}); len(tokens) > 0 {
+		values := map[string]string{}
+		for name, value := range tokens {
+			values[name] = value.(string)
+		}
+		builtPath, err = pattern.Build(values)
+		if err != nil {
+			return append(diags, diag.Errorf("failed to build path from tokens: %s", err)...)
+		}
+	}
+	errors := setValue("built_path", builtPath)
+	if errors != nil && len(errors) > 0 {
+		return append(diags, diag.Errorf("failed to pack repository layout path %q", errors)...)
+	}
+
+	id := strings.Join([]string{pathPattern, data.GetString("path", false), builtPath}, "\n")
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(id))))
+	return diags
+}
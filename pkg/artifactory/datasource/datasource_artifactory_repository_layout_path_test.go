@@ -0,0 +1,92 @@
+package datasource_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/acctest"
+)
+
+func TestAccDataSourceRepositoryLayoutPath(t *testing.T) {
+	const config = `
+		data "artifactory_repository_layout_path" "maven" {
+		  layout_name = "maven-2-default"
+		  path        = "org/acme/foo/1.0-SNAPSHOT/foo-1.0-20230101.123456-1-sources.jar"
+		  tokens = {
+		    org     = "org.acme"
+		    module  = "foo"
+		    baseRev = "2.0"
+		    ext     = "jar"
+		  }
+		}
+	`
+	const fqrn = "data.artifactory_repository_layout_path.maven"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "matches", "true"),
+					resource.TestCheckResourceAttr(fqrn, "parsed_tokens.orgPath", "org/acme"),
+					resource.TestCheckResourceAttr(fqrn, "parsed_tokens.module", "foo"),
+					resource.TestCheckResourceAttr(fqrn, "parsed_tokens.baseRev", "1.0"),
+					resource.TestCheckResourceAttr(fqrn, "parsed_tokens.folderItegRev", "SNAPSHOT"),
+					resource.TestCheckResourceAttr(fqrn, "parsed_tokens.fileItegRev", "20230101.123456-1"),
+					resource.TestCheckResourceAttr(fqrn, "parsed_tokens.classifier", "sources"),
+					resource.TestCheckResourceAttr(fqrn, "parsed_tokens.ext", "jar"),
+					resource.TestCheckResourceAttr(fqrn, "built_path", "org/acme/foo/2.0/foo-2.0.jar"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceRepositoryLayoutPath_javaRevisionRegexp(t *testing.T) {
+	const config = `
+		data "artifactory_repository_layout_path" "custom" {
+		  artifact_path_pattern              = "[orgPath]/[module]/[baseRev](-[folderItegRev])/[module]-[baseRev](-[fileItegRev]).[ext]"
+		  folder_integration_revision_regexp = "SNAPSHOT(?!-RC)"
+		  file_integration_revision_regexp   = "SNAPSHOT(?!-RC)"
+		  path                               = "org/acme/foo/1.0-SNAPSHOT/foo-1.0-SNAPSHOT.jar"
+		}
+	`
+	const fqrn = "data.artifactory_repository_layout_path.custom"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "matches", "true"),
+					resource.TestCheckResourceAttr(fqrn, "parsed_tokens.folderItegRev", "SNAPSHOT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceRepositoryLayoutPath_invalid_pattern(t *testing.T) {
+	const config = `
+		data "artifactory_repository_layout_path" "custom" {
+		  artifact_path_pattern = "[orgPath]/[module]/[baseRev](-[folderItegRev]/[module]-[baseRev].[ext]"
+		  path                  = "org/acme/foo/1.0/foo-1.0.jar"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(".*unbalanced '\\('.*"),
+			},
+		},
+	})
+}
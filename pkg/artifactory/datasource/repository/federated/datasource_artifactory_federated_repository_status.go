@@ -0,0 +1,240 @@
+package federated
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository/federated"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+)
+
+const (
+	FederationStatusEndpoint     = "artifactory/api/federation/status/repo/{key}"
+	FederationMirrorsLagEndpoint = "artifactory/api/federation/status/mirrorsLag"
+)
+
+const mirrorStatusSynchronized = "SYNCHRONIZED"
+
+type mirrorEventsStatus struct {
+	CreateEvents int `json:"createEvents"`
+	UpdateEvents int `json:"updateEvents"`
+	DeleteEvents int `json:"deleteEvents"`
+	PropsEvents  int `json:"propsEvents"`
+	ErrorEvents  int `json:"errorEvents"`
+}
+
+func (s mirrorEventsStatus) queued() int {
+	return s.CreateEvents + s.UpdateEvents + s.DeleteEvents + s.PropsEvents
+}
+
+type mirrorStatus struct {
+	LocalRepoKey           string             `json:"localRepoKey"`
+	RemoteUrl              string             `json:"remoteUrl"`
+	RemoteRepoKey          string             `json:"remoteRepoKey"`
+	Status                 string             `json:"status"`
+	LastEventTime          int64              `json:"lastEventTime"`
+	MirrorEventsStatusInfo mirrorEventsStatus `json:"mirrorEventsStatusInfo"`
+}
+
+type federationStatus struct {
+	LocalKey          string `json:"localKey"`
+	BinariesTasksInfo struct {
+		InProgressTasks int `json:"inProgressTasks"`
+		FailingTasks    int `json:"failingTasks"`
+	} `json:"binariesTasksInfo"`
+	Mirrors []mirrorStatus `json:"mirrors"`
+}
+
+type mirrorLag struct {
+	LocalRepoKey  string `json:"localRepoKey"`
+	RemoteUrl     string `json:"remoteUrl"`
+	RemoteRepoKey string `json:"remoteRepoKey"`
+	LagInMS       int64  `json:"lagInMS"`
+}
+
+// sameMemberUrl compares member URLs the way Artifactory does, ignoring the case and a trailing slash.
+func sameMemberUrl(a, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, "/"), strings.TrimSuffix(b, "/"))
+}
+
+func DataSourceArtifactoryFederatedRepositoryStatus() *schema.Resource {
+	var memberStatusSchema = map[string]*schema.Schema{
+		"url": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "URL of the member.",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Represents the active state of the member, as configured in the repository.",
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "Status of the mirror to the member as reported by Artifactory, e.g. `SYNCHRONIZED`, or `UNKNOWN` " +
+				"if Artifactory reports no mirror for the member.",
+		},
+		"last_sync_time": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time of the last event sent to the member, in RFC 3339 format. Empty if no event was sent yet.",
+		},
+		"lag_ms": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Time, in milliseconds, the oldest event not yet sent to the member has been waiting for.",
+		},
+		"queued_events": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of create, update, delete and properties events waiting to be sent to the member.",
+		},
+		"error_events": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of events which failed to be sent to the member.",
+		},
+		"healthy": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "`true` if the member is disabled, or synchronized without failed events.",
+		},
+	}
+
+	var statusSchema = map[string]*schema.Schema{
+		"key": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "Federated repository key.",
+		},
+		"in_progress_binary_tasks": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of binaries being transferred to the members.",
+		},
+		"failing_binary_tasks": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of binaries which failed to be transferred to the members.",
+		},
+		"healthy": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "`true` if all the members are healthy and no binary transfer is failing.",
+		},
+		"member": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Resource{Schema: memberStatusSchema},
+			Description: "Status of each member of the repository, other than the repository itself, in the configured order.",
+		},
+	}
+
+	var dataSourceRead = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		key := d.Get("key").(string)
+		c := m.(utilsdk.ProvderMetadata).Client
+
+		repo := struct {
+			Rclass  string             `json:"rclass"`
+			Members []federated.Member `json:"members"`
+		}{}
+		_, err := c.R().
+			SetResult(&repo).
+			SetPathParam("key", key).
+			Get(federated.RepositoriesEndpoint)
+		if err != nil {
+			return diag.Errorf("failed to read repository %s: %s", key, err)
+		}
+		if repo.Rclass != rclass {
+			return diag.Errorf("repository %s is not a federated repository, rclass is %q", key, repo.Rclass)
+		}
+
+		status := federationStatus{}
+		_, err = c.R().
+			SetResult(&status).
+			SetPathParam("key", key).
+			Get(FederationStatusEndpoint)
+		if err != nil {
+			return diag.Errorf("failed to read federation status of repository %s: %s", key, err)
+		}
+
+		var lags []mirrorLag
+		_, err = c.R().
+			SetResult(&lags).
+			Get(FederationMirrorsLagEndpoint)
+		if err != nil {
+			return diag.Errorf("failed to read federation mirrors lag: %s", err)
+		}
+
+		healthy := status.BinariesTasksInfo.FailingTasks == 0
+		var members []interface{}
+		for _, member := range repo.Members {
+			// the repository itself is a member, without mirror
+			if strings.HasSuffix(strings.TrimSuffix(member.Url, "/"), "/"+key) && strings.HasPrefix(member.Url, c.BaseURL) {
+				continue
+			}
+
+			mirror := mirrorStatus{Status: "UNKNOWN"}
+			for _, s := range status.Mirrors {
+				if sameMemberUrl(s.RemoteUrl, member.Url) {
+					mirror = s
+					break
+				}
+			}
+
+			var lagMs int64
+			for _, lag := range lags {
+				if lag.LocalRepoKey == key && sameMemberUrl(lag.RemoteUrl, member.Url) {
+					lagMs = lag.LagInMS
+					break
+				}
+			}
+
+			lastSyncTime := ""
+			if mirror.LastEventTime > 0 {
+				lastSyncTime = time.UnixMilli(mirror.LastEventTime).UTC().Format(time.RFC3339)
+			}
+
+			memberHealthy := !member.Enabled ||
+				(mirror.Status == mirrorStatusSynchronized && mirror.MirrorEventsStatusInfo.ErrorEvents == 0)
+			healthy = healthy && memberHealthy
+
+			members = append(members, map[string]interface{}{
+				"url":            member.Url,
+				"enabled":        member.Enabled,
+				"status":         mirror.Status,
+				"last_sync_time": lastSyncTime,
+				"lag_ms":         int(lagMs),
+				"queued_events":  mirror.MirrorEventsStatusInfo.queued(),
+				"error_events":   mirror.MirrorEventsStatusInfo.ErrorEvents,
+				"healthy":        memberHealthy,
+			})
+		}
+
+		d.SetId(key)
+
+		setValue := utilsdk.MkLens(d)
+		setValue("in_progress_binary_tasks", status.BinariesTasksInfo.InProgressTasks)
+		setValue("failing_binary_tasks", status.BinariesTasksInfo.FailingTasks)
+		setValue("healthy", healthy)
+		errors := setValue("member", members)
+		if errors != nil && len(errors) > 0 {
+			return diag.Errorf("failed to pack federated repository status %q", errors)
+		}
+
+		return nil
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Schema:      statusSchema,
+		Description: "Provides the synchronization status of the members of a federated repository, from the " +
+			"federation status and mirrors lag APIs of Artifactory.",
+	}
+}
@@ -1081,3 +1081,59 @@ func TestAccFederatedTerraformRepositories(t *testing.T) {
 		})
 	}
 }
+
+func TestAccDataSourceFederatedRepositoryStatus(t *testing.T) {
+	if skip, reason := skipFederatedRepo(); skip {
+		t.Skipf(reason)
+	}
+
+	name := fmt.Sprintf("federated-generic-%d-status", rand.Int())
+	dataSourceName := fmt.Sprintf("data.artifactory_federated_repository_status.%s", name)
+	federatedMember1Url := fmt.Sprintf("%s/artifactory/%s", acctest.GetArtifactoryUrl(t), name)
+	federatedMember2Url := fmt.Sprintf("%s/artifactory/%s", os.Getenv("ARTIFACTORY_URL_2"), name)
+
+	params := map[string]interface{}{
+		"name":       name,
+		"member1Url": federatedMember1Url,
+		"member2Url": federatedMember2Url,
+	}
+	config := utilsdk.ExecuteTemplate("TestAccDataSourceFederatedRepositoryStatus", `
+		resource "artifactory_federated_generic_repository" "{{ .name }}" {
+			key               = "{{ .name }}"
+			cleanup_on_delete = true
+
+			member {
+				url     = "{{ .member1Url }}"
+				enabled = true
+			}
+
+			member {
+				url     = "{{ .member2Url }}"
+				enabled = true
+			}
+		}
+
+		data "artifactory_federated_repository_status" "{{ .name }}" {
+			key = artifactory_federated_generic_repository.{{ .name }}.id
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "key", name),
+					resource.TestCheckResourceAttr(dataSourceName, "member.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "member.0.url", federatedMember2Url),
+					resource.TestCheckResourceAttr(dataSourceName, "member.0.enabled", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "member.0.status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "member.0.lag_ms"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "healthy"),
+				),
+			},
+		},
+	})
+}
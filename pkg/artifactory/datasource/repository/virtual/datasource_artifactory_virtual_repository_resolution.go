@@ -0,0 +1,274 @@
+package virtual
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/antpath"
+	resource_repository "github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+)
+
+// resolutionRepository holds the subset of the repository configuration that takes part in artifact resolution.
+type resolutionRepository struct {
+	Key                string   `json:"key"`
+	Rclass             string   `json:"rclass"`
+	PackageType        string   `json:"packageType"`
+	IncludesPattern    string   `json:"includesPattern"`
+	ExcludesPattern    string   `json:"excludesPattern"`
+	BlackedOut         bool     `json:"blackedOut"`
+	Offline            bool     `json:"offline"`
+	PriorityResolution bool     `json:"priorityResolution"`
+	Repositories       []string `json:"repositories"`
+}
+
+type resolutionMember struct {
+	resolutionRepository
+	Parent   string
+	Eligible bool
+	Reason   string
+}
+
+type repositoryGetter func(key string) (resolutionRepository, error)
+
+func DataSourceArtifactoryVirtualRepositoryResolution() *schema.Resource {
+	var memberSchema = map[string]*schema.Schema{
+		"key": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Member repository key.",
+		},
+		"rclass": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Member repository class: `local`, `remote`, `federated` or `virtual`.",
+		},
+		"package_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"parent": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Key of the virtual repository that includes this member. Differs from `key` argument for members of nested virtual repositories.",
+		},
+		"priority_resolution": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"offline": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Remote repository is offline, only locally cached artifacts are served.",
+		},
+		"eligible": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Member may serve the path.",
+		},
+		"reason": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Why the member was skipped, or a note about how it serves the path.",
+		},
+	}
+
+	var resolutionSchema = map[string]*schema.Schema{
+		"key": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: resource_repository.RepoKeyValidator,
+			Description:  "Virtual repository key.",
+		},
+		"path": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "Artifact path within the virtual repository, e.g. `com/acme/foo/1.0/foo.jar`.",
+		},
+		"eligible_repositories": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Computed:    true,
+			Description: "Keys of the members eligible to serve the path, in resolution order.",
+		},
+		"members": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Resource{Schema: memberSchema},
+			Description: "All members of the virtual repository, including members of nested virtual repositories, in the configured order.",
+		},
+	}
+
+	var dataSourceRead = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		key := d.Get("key").(string)
+		path := d.Get("path").(string)
+
+		getRepository := func(repoKey string) (resolutionRepository, error) {
+			repo := resolutionRepository{}
+			_, err := m.(utilsdk.ProvderMetadata).Client.R().
+				SetResult(&repo).
+				SetPathParam("key", repoKey).
+				Get(resource_repository.RepositoriesEndpoint)
+			return repo, err
+		}
+
+		virtualRepo, err := getRepository(key)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if virtualRepo.Rclass != rclass {
+			return diag.Errorf("repository %s is not a virtual repository, rclass is %q", key, virtualRepo.Rclass)
+		}
+
+		members, err := resolveMembers(virtualRepo, path, getRepository)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(fmt.Sprintf("%s/%s", key, path))
+
+		return packResolution(members, d)
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Schema:      resolutionSchema,
+		Description: "Simulates artifact resolution of a virtual repository offline. Returns the members eligible to serve " +
+			"the path, in resolution order, and the reason each of the other members was skipped.",
+	}
+}
+
+// resolveMembers walks the members of the virtual repository (recursing into nested virtual repositories) and
+// evaluates each against path. The returned slice keeps the configured order; use eligibleInResolutionOrder to
+// get the order in which Artifactory queries the eligible members.
+func resolveMembers(virtualRepo resolutionRepository, path string, getRepository repositoryGetter) ([]resolutionMember, error) {
+	var members []resolutionMember
+	visited := map[string]bool{virtualRepo.Key: true}
+
+	var walk func(parent resolutionRepository, parentReason string) error
+	walk = func(parent resolutionRepository, parentReason string) error {
+		for _, memberKey := range parent.Repositories {
+			if visited[memberKey] {
+				continue
+			}
+			visited[memberKey] = true
+
+			repo, err := getRepository(memberKey)
+			if err != nil {
+				return fmt.Errorf("failed to get member repository %s of %s: %w", memberKey, parent.Key, err)
+			}
+			repo.Key = memberKey
+
+			reason := parentReason
+			if reason == "" {
+				reason = skipReason(repo, path)
+			}
+
+			if repo.Rclass == rclass {
+				if err := walk(repo, reason); err != nil {
+					return err
+				}
+				continue
+			}
+
+			member := resolutionMember{
+				resolutionRepository: repo,
+				Parent:               parent.Key,
+				Eligible:             reason == "",
+				Reason:               reason,
+			}
+			if member.Eligible && repo.Offline {
+				member.Reason = "remote repository is offline, only locally cached artifacts are served"
+			}
+			members = append(members, member)
+		}
+		return nil
+	}
+
+	rootReason := ""
+	if !antpath.IsIncluded(virtualRepo.IncludesPattern, virtualRepo.ExcludesPattern, path) {
+		rootReason = fmt.Sprintf("path is filtered out by includes/excludes patterns of virtual repository %s", virtualRepo.Key)
+	}
+
+	if err := walk(virtualRepo, rootReason); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+func skipReason(repo resolutionRepository, path string) string {
+	if repo.BlackedOut {
+		return "repository is blacked out"
+	}
+	if !antpath.IsIncluded(repo.IncludesPattern, repo.ExcludesPattern, path) {
+		if repo.Rclass == rclass {
+			return fmt.Sprintf("path is filtered out by includes/excludes patterns of virtual repository %s", repo.Key)
+		}
+		return "path is filtered out by includes/excludes patterns"
+	}
+	return ""
+}
+
+// eligibleInResolutionOrder returns the eligible members in the order Artifactory queries them: repositories with
+// priority resolution first, then local (and federated) repositories before remote ones, each in the configured order.
+func eligibleInResolutionOrder(members []resolutionMember) []string {
+	var eligible []resolutionMember
+	for _, member := range members {
+		if member.Eligible {
+			eligible = append(eligible, member)
+		}
+	}
+
+	rank := func(member resolutionMember) int {
+		r := 0
+		if !member.PriorityResolution {
+			r += 2
+		}
+		if member.Rclass == "remote" {
+			r++
+		}
+		return r
+	}
+	sort.SliceStable(eligible, func(i, j int) bool {
+		return rank(eligible[i]) < rank(eligible[j])
+	})
+
+	keys := make([]string, 0, len(eligible))
+	for _, member := range eligible {
+		keys = append(keys, member.Key)
+	}
+	return keys
+}
+
+func packResolution(members []resolutionMember, d *schema.ResourceData) diag.Diagnostics {
+	setValue := utilsdk.MkLens(d)
+
+	packedMembers := make([]interface{}, 0, len(members))
+	for _, member := range members {
+		packedMembers = append(packedMembers, map[string]interface{}{
+			"key":                 member.Key,
+			"rclass":              member.Rclass,
+			"package_type":        member.PackageType,
+			"parent":              member.Parent,
+			"priority_resolution": member.PriorityResolution,
+			"offline":             member.Offline,
+			"eligible":            member.Eligible,
+			"reason":              member.Reason,
+		})
+	}
+
+	setValue("eligible_repositories", eligibleInResolutionOrder(members))
+	errors := setValue("members", packedMembers)
+
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed to pack virtual repository resolution %q", errors)
+	}
+
+	return nil
+}
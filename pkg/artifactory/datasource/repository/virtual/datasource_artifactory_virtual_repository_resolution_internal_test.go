@@ -0,0 +1,180 @@
+package virtual
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeRepositories returns a repositoryGetter serving the repositories from memory.
+func fakeRepositories(repos ...resolutionRepository) repositoryGetter {
+	byKey := map[string]resolutionRepository{}
+	for _, repo := range repos {
+		byKey[repo.Key] = repo
+	}
+	return func(key string) (resolutionRepository, error) {
+		repo, ok := byKey[key]
+		if !ok {
+			return resolutionRepository{}, fmt.Errorf("repository %s not found", key)
+		}
+		return repo, nil
+	}
+}
+
+func TestResolveMembers(t *testing.T) {
+	const path = "com/acme/foo/1.0/foo-1.0.jar"
+
+	type expectedMember struct {
+		key      string
+		parent   string
+		eligible bool
+		reason   string
+	}
+
+	for _, testCase := range []struct {
+		name     string
+		virtual  resolutionRepository
+		repos    []resolutionRepository
+		members  []expectedMember
+		eligible []string
+	}{
+		{
+			name:    "local before remote, in configured order",
+			virtual: resolutionRepository{Key: "libs", Rclass: rclass, Repositories: []string{"remote-1", "local-1", "remote-2", "local-2"}},
+			repos: []resolutionRepository{
+				{Key: "remote-1", Rclass: "remote"},
+				{Key: "local-1", Rclass: "local"},
+				{Key: "remote-2", Rclass: "remote"},
+				{Key: "local-2", Rclass: "local"},
+			},
+			members: []expectedMember{
+				{key: "remote-1", parent: "libs", eligible: true},
+				{key: "local-1", parent: "libs", eligible: true},
+				{key: "remote-2", parent: "libs", eligible: true},
+				{key: "local-2", parent: "libs", eligible: true},
+			},
+			eligible: []string{"local-1", "local-2", "remote-1", "remote-2"},
+		},
+		{
+			name:    "priority resolution first",
+			virtual: resolutionRepository{Key: "libs", Rclass: rclass, Repositories: []string{"local-1", "remote-1", "remote-priority"}},
+			repos: []resolutionRepository{
+				{Key: "local-1", Rclass: "local"},
+				{Key: "remote-1", Rclass: "remote"},
+				{Key: "remote-priority", Rclass: "remote", PriorityResolution: true},
+			},
+			members: []expectedMember{
+				{key: "local-1", parent: "libs", eligible: true},
+				{key: "remote-1", parent: "libs", eligible: true},
+				{key: "remote-priority", parent: "libs", eligible: true},
+			},
+			eligible: []string{"remote-priority", "local-1", "remote-1"},
+		},
+		{
+			name:    "blacked out and filtered out members are skipped",
+			virtual: resolutionRepository{Key: "libs", Rclass: rclass, Repositories: []string{"blacked-out", "excluded", "not-included", "local-1"}},
+			repos: []resolutionRepository{
+				{Key: "blacked-out", Rclass: "local", BlackedOut: true},
+				{Key: "excluded", Rclass: "local", ExcludesPattern: "**/*.jar"},
+				{Key: "not-included", Rclass: "local", IncludesPattern: "org/**"},
+				{Key: "local-1", Rclass: "local", IncludesPattern: "com/acme/**"},
+			},
+			members: []expectedMember{
+				{key: "blacked-out", parent: "libs", reason: "repository is blacked out"},
+				{key: "excluded", parent: "libs", reason: "path is filtered out by includes/excludes patterns"},
+				{key: "not-included", parent: "libs", reason: "path is filtered out by includes/excludes patterns"},
+				{key: "local-1", parent: "libs", eligible: true},
+			},
+			eligible: []string{"local-1"},
+		},
+		{
+			name:    "offline remote is eligible with a note",
+			virtual: resolutionRepository{Key: "libs", Rclass: rclass, Repositories: []string{"remote-offline"}},
+			repos: []resolutionRepository{
+				{Key: "remote-offline", Rclass: "remote", Offline: true},
+			},
+			members: []expectedMember{
+				{key: "remote-offline", parent: "libs", eligible: true, reason: "remote repository is offline, only locally cached artifacts are served"},
+			},
+			eligible: []string{"remote-offline"},
+		},
+		{
+			name:    "nested virtual members are flattened",
+			virtual: resolutionRepository{Key: "libs", Rclass: rclass, Repositories: []string{"nested", "local-1"}},
+			repos: []resolutionRepository{
+				{Key: "nested", Rclass: rclass, Repositories: []string{"remote-1", "local-2"}},
+				{Key: "remote-1", Rclass: "remote"},
+				{Key: "local-1", Rclass: "local"},
+				{Key: "local-2", Rclass: "local"},
+			},
+			members: []expectedMember{
+				{key: "remote-1", parent: "nested", eligible: true},
+				{key: "local-2", parent: "nested", eligible: true},
+				{key: "local-1", parent: "libs", eligible: true},
+			},
+			eligible: []string{"local-2", "local-1", "remote-1"},
+		},
+		{
+			name:    "nested virtual patterns filter its members",
+			virtual: resolutionRepository{Key: "libs", Rclass: rclass, Repositories: []string{"nested", "local-1"}},
+			repos: []resolutionRepository{
+				{Key: "nested", Rclass: rclass, ExcludesPattern: "com/**", Repositories: []string{"local-2"}},
+				{Key: "local-1", Rclass: "local"},
+				{Key: "local-2", Rclass: "local"},
+			},
+			members: []expectedMember{
+				{key: "local-2", parent: "nested", reason: "path is filtered out by includes/excludes patterns of virtual repository nested"},
+				{key: "local-1", parent: "libs", eligible: true},
+			},
+			eligible: []string{"local-1"},
+		},
+		{
+			name:    "root virtual patterns filter all members",
+			virtual: resolutionRepository{Key: "libs", Rclass: rclass, IncludesPattern: "org/**", Repositories: []string{"local-1"}},
+			repos: []resolutionRepository{
+				{Key: "local-1", Rclass: "local"},
+			},
+			members: []expectedMember{
+				{key: "local-1", parent: "libs", reason: "path is filtered out by includes/excludes patterns of virtual repository libs"},
+			},
+			eligible: []string{},
+		},
+		{
+			name:    "members and cycles are visited once",
+			virtual: resolutionRepository{Key: "libs", Rclass: rclass, Repositories: []string{"nested", "local-1"}},
+			repos: []resolutionRepository{
+				{Key: "nested", Rclass: rclass, Repositories: []string{"libs", "local-1"}},
+				{Key: "local-1", Rclass: "local"},
+			},
+			members: []expectedMember{
+				{key: "local-1", parent: "nested", eligible: true},
+			},
+			eligible: []string{"local-1"},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			members, err := resolveMembers(testCase.virtual, path, fakeRepositories(testCase.repos...))
+			assert.NoError(t, err)
+
+			actual := make([]expectedMember, 0, len(members))
+			for _, member := range members {
+				actual = append(actual, expectedMember{
+					key:      member.Key,
+					parent:   member.Parent,
+					eligible: member.Eligible,
+					reason:   member.Reason,
+				})
+			}
+			assert.Equal(t, testCase.members, actual)
+			assert.Equal(t, testCase.eligible, eligibleInResolutionOrder(members))
+		})
+	}
+}
+
+func TestResolveMembers_getterError(t *testing.T) {
+	virtual := resolutionRepository{Key: "libs", Rclass: rclass, Repositories: []string{"missing"}}
+
+	_, err := resolveMembers(virtual, "foo.jar", fakeRepositories())
+	assert.ErrorContains(t, err, "failed to get member repository missing of libs")
+}
@@ -270,6 +270,72 @@ func TestAccDataSourceVirtualRpmRepository(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceVirtualRepositoryResolution(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("virtual-resolution-", "artifactory_virtual_generic_repository")
+	dataSourceFqrn := fmt.Sprintf("data.artifactory_virtual_repository_resolution.%s", name)
+
+	config := utilsdk.ExecuteTemplate("TestAccDataSourceVirtualRepositoryResolution", `
+		resource "artifactory_remote_generic_repository" "{{ .name }}-remote" {
+			key                  = "{{ .name }}-remote"
+			url                  = "https://tempurl.org"
+			priority_resolution  = true
+		}
+
+		resource "artifactory_local_generic_repository" "{{ .name }}-local" {
+			key = "{{ .name }}-local"
+		}
+
+		resource "artifactory_local_generic_repository" "{{ .name }}-excluded" {
+			key              = "{{ .name }}-excluded"
+			excludes_pattern = "com/acme/**"
+		}
+
+		resource "artifactory_local_generic_repository" "{{ .name }}-blacked-out" {
+			key         = "{{ .name }}-blacked-out"
+			blacked_out = true
+		}
+
+		resource "artifactory_virtual_generic_repository" "{{ .name }}" {
+			key          = "{{ .name }}"
+			repositories = [
+				artifactory_local_generic_repository.{{ .name }}-excluded.key,
+				artifactory_remote_generic_repository.{{ .name }}-remote.key,
+				artifactory_local_generic_repository.{{ .name }}-blacked-out.key,
+				artifactory_local_generic_repository.{{ .name }}-local.key,
+			]
+		}
+
+		data "artifactory_virtual_repository_resolution" "{{ .name }}" {
+			key  = artifactory_virtual_generic_repository.{{ .name }}.key
+			path = "com/acme/foo/1.0/foo.jar"
+		}
+	`, map[string]interface{}{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFqrn, "eligible_repositories.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "eligible_repositories.0", fmt.Sprintf("%s-remote", name)),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "eligible_repositories.1", fmt.Sprintf("%s-local", name)),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "members.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "members.0.key", fmt.Sprintf("%s-excluded", name)),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "members.0.eligible", "false"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "members.0.reason", "path is filtered out by includes/excludes patterns"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "members.2.eligible", "false"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "members.2.reason", "repository is blacked out"),
+				),
+			},
+		},
+	})
+}
+
 func mkNewVirtualTestCase(packageType string, t *testing.T, extraFields map[string]interface{}) (*testing.T, resource.TestCase) {
 	_, fqrn, name := testutil.MkNames(fmt.Sprintf("terraform-virtual-%s-repo-full-", packageType),
 		fmt.Sprintf("artifactory_virtual_%s_repository", packageType))
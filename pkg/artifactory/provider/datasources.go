@@ -23,6 +23,13 @@ func datasourcesMap() map[string]*schema.Resource {
 	dataSourcesMap := map[string]*schema.Resource{
 		"artifactory_file":                                    datasource.ArtifactoryFile(),
 		"artifactory_fileinfo":                                datasource.ArtifactoryFileInfo(),
+		"artifactory_repository_layout_path":                  datasource.ArtifactoryRepositoryLayoutPath(),
+		"artifactory_aql_search":                              datasource.ArtifactoryAqlSearch(),
+		"artifactory_checksum_search":                         datasource.ArtifactoryChecksumSearch(),
+		"artifactory_gavc_search":                             datasource.ArtifactoryGavcSearch(),
+		"artifactory_artifact_versions":                       datasource.ArtifactoryArtifactVersions(),
+		"artifactory_file_list":                               datasource.ArtifactoryFileList(),
+		"artifactory_files":                                   datasource.ArtifactoryFiles(),
 		"artifactory_group":                                   datasource_security.DataSourceArtifactoryGroup(),
 		"artifactory_permission_target":                       datasource_security.DataSourceArtifactoryPermissionTarget(),
 		"artifactory_user":                                    datasource_user.DataSourceArtifactoryUser(),
@@ -59,6 +66,7 @@ func datasourcesMap() map[string]*schema.Resource {
 		"artifactory_virtual_npm_repository":                  datasource_virtual.DatasourceArtifactoryVirtualNpmRepository(),
 		"artifactory_virtual_nuget_repository":                datasource_virtual.DatasourceArtifactoryVirtualNugetRepository(),
 		"artifactory_virtual_rpm_repository":                  datasource_virtual.DatasourceArtifactoryVirtualRpmRepository(),
+		"artifactory_virtual_repository_resolution":           datasource_virtual.DataSourceArtifactoryVirtualRepositoryResolution(),
 		"artifactory_federated_alpine_repository":             datasource_federated.DataSourceArtifactoryFederatedAlpineRepository(),
 		"artifactory_federated_cargo_repository":              datasource_federated.DataSourceArtifactoryFederatedCargoRepository(),
 		"artifactory_federated_debian_repository":             datasource_federated.DataSourceArtifactoryFederatedDebianRepository(),
@@ -70,6 +78,8 @@ func datasourcesMap() map[string]*schema.Resource {
 		"artifactory_federated_rpm_repository":                datasource_federated.DataSourceArtifactoryFederatedRpmRepository(),
 		"artifactory_federated_terraform_module_repository":   datasource_federated.DataSourceArtifactoryFederatedTerraformRepository("module"),
 		"artifactory_federated_terraform_provider_repository": datasource_federated.DataSourceArtifactoryFederatedTerraformRepository("provider"),
+
+		"artifactory_federated_repository_status": datasource_federated.DataSourceArtifactoryFederatedRepositoryStatus(),
 	}
 
 	for _, packageType := range repository.GradleLikePackageTypes {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/artifact"
 	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/configuration"
 	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/replication"
 	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository"
@@ -31,6 +32,7 @@ func resourcesMap() map[string]*schema.Resource {
 		"artifactory_federated_rpm_repository":                federated.ResourceArtifactoryFederatedRpmRepository(),
 		"artifactory_federated_terraform_module_repository":   federated.ResourceArtifactoryFederatedTerraformRepository("module"),
 		"artifactory_federated_terraform_provider_repository": federated.ResourceArtifactoryFederatedTerraformRepository("provider"),
+		"artifactory_federated_repository_conversion":         federated.ResourceArtifactoryFederatedRepositoryConversion(),
 		"artifactory_local_nuget_repository":                  local.ResourceArtifactoryLocalNugetRepository(),
 		"artifactory_local_maven_repository":                  local.ResourceArtifactoryLocalJavaRepository("maven", false),
 		"artifactory_local_alpine_repository":                 local.ResourceArtifactoryLocalAlpineRepository(),
@@ -55,6 +57,9 @@ func resourcesMap() map[string]*schema.Resource {
 		"artifactory_remote_pypi_repository":                  remote.ResourceArtifactoryRemotePypiRepository(),
 		"artifactory_remote_terraform_repository":             remote.ResourceArtifactoryRemoteTerraformRepository(),
 		"artifactory_remote_vcs_repository":                   remote.ResourceArtifactoryRemoteVcsRepository(),
+		"artifactory_remote_repository_cache_action":          remote.ResourceArtifactoryRemoteRepositoryCacheAction(),
+		"artifactory_repository_reindex":                      repository.ResourceArtifactoryRepositoryReindex(),
+		"artifactory_repository_project_share":                repository.ResourceArtifactoryRepositoryProjectShare(),
 		"artifactory_virtual_alpine_repository":               virtual.ResourceArtifactoryVirtualAlpineRepository(),
 		"artifactory_virtual_bower_repository":                virtual.ResourceArtifactoryVirtualBowerRepository(),
 		"artifactory_virtual_debian_repository":               virtual.ResourceArtifactoryVirtualDebianRepository(),
@@ -86,6 +91,10 @@ func resourcesMap() map[string]*schema.Resource {
 		"artifactory_repository_layout":                       configuration.ResourceArtifactoryRepositoryLayout(),
 		"artifactory_property_set":                            configuration.ResourceArtifactoryPropertySet(),
 		"artifactory_proxy":                                   configuration.ResourceArtifactoryProxy(),
+		"artifactory_global_environment":                      configuration.ResourceArtifactoryGlobalEnvironment(),
+		"artifactory_artifact":                                artifact.ResourceArtifactoryArtifact(),
+		"artifactory_directory_sync":                          artifact.ResourceArtifactoryDirectorySync(),
+		"artifactory_artifact_copy":                           artifact.ResourceArtifactoryArtifactCopy(),
 	}
 
 	for _, repoType := range local.PackageTypesLikeGeneric {
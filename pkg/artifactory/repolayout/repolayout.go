@@ -0,0 +1,386 @@
+// Package repolayout compiles Artifactory repository layout path patterns, e.g.
+// `[orgPath]/[module]/[baseRev](-[folderItegRev])/[module]-[baseRev](-[fileItegRev])(-[classifier]).[ext]`, into
+// regular expressions the way Artifactory does, to parse artifact paths into tokens and to build paths from tokens.
+package repolayout
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	TokenOrg           = "org"
+	TokenOrgPath       = "orgPath"
+	TokenModule        = "module"
+	TokenBaseRev       = "baseRev"
+	TokenFolderItegRev = "folderItegRev"
+	TokenFileItegRev   = "fileItegRev"
+	TokenClassifier    = "classifier"
+	TokenExt           = "ext"
+	TokenType          = "type"
+)
+
+// tokenRegexps mirrors the expressions Artifactory uses for the built-in tokens. Go regexp has no look-ahead, so
+// `[ext]` (which must not start with a digit) is expressed with a character class instead.
+var tokenRegexps = map[string]string{
+	TokenOrg:        `[^/]+?`,
+	TokenOrgPath:    `.+?`,
+	TokenModule:     `[^/]+?`,
+	TokenBaseRev:    `[^/]+?`,
+	TokenClassifier: `[^/]+?`,
+	TokenExt:        `[^/0-9][^/]*?`,
+	TokenType:       `[^/]+?`,
+}
+
+var tokenNameRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+type element struct {
+	literal  string
+	token    string
+	custom   string // regexp of a custom token, e.g. [channel<[^/]+>]
+	optional []element
+}
+
+// Pattern is a compiled layout path pattern.
+type Pattern struct {
+	Source string
+
+	elements      []element
+	folderItegRev string
+	fileItegRev   string
+	regexp        *regexp.Regexp
+	groupTokens   []string
+}
+
+// Compile parses a layout path pattern. folderItegRevRegexp and fileItegRevRegexp are the layout's
+// `folder_integration_revision_regexp` and `file_integration_revision_regexp`, `.*` is used when empty.
+func Compile(pathPattern, folderItegRevRegexp, fileItegRevRegexp string) (*Pattern, error) {
+	elements, rest, err := parseElements(pathPattern, false)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, fmt.Errorf("unbalanced ')' in path pattern %q", pathPattern)
+	}
+
+	p := &Pattern{
+		Source:        pathPattern,
+		elements:      elements,
+		folderItegRev: defaultRegexp(folderItegRevRegexp),
+		fileItegRev:   defaultRegexp(fileItegRevRegexp),
+	}
+
+	for name, value := range map[string]string{
+		"folder integration revision": p.folderItegRev,
+		"file integration revision":   p.fileItegRev,
+	} {
+		if _, err := regexp.Compile(value); err != nil {
+			return nil, fmt.Errorf("invalid %s regexp %q: %w", name, value, err)
+		}
+	}
+
+	p.regexp, p.groupTokens, err = p.compile(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// FallbackRevisionRegexp replaces an integration revision regexp Go can't compile, e.g. a Java one with a lookahead, so
+// the paths of the layout can still be parsed approximately.
+const FallbackRevisionRegexp = `[^/]+?`
+
+// GoRevisionRegexp returns expr if Go can compile it, FallbackRevisionRegexp and false otherwise.
+func GoRevisionRegexp(expr string) (string, bool) {
+	if _, err := regexp.Compile(defaultRegexp(expr)); err != nil {
+		return FallbackRevisionRegexp, false
+	}
+	return expr, true
+}
+
+func defaultRegexp(value string) string {
+	if value == "" {
+		return ".*"
+	}
+	return value
+}
+
+// parseElements parses pattern until the end or, when nested, until the closing ')' of an optional group. It
+// returns the remaining input after the closing ')'.
+func parseElements(pattern string, nested bool) ([]element, string, error) {
+	var elements []element
+	var literal strings.Builder
+
+	flush := func() {
+		if literal.Len() > 0 {
+			elements = append(elements, element{literal: literal.String()})
+			literal.Reset()
+		}
+	}
+
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '[':
+			flush()
+			token, rest, err := parseToken(pattern)
+			if err != nil {
+				return nil, "", err
+			}
+			elements = append(elements, token)
+			pattern = rest
+		case '(':
+			flush()
+			optional, rest, err := parseElements(pattern[1:], true)
+			if err != nil {
+				return nil, "", err
+			}
+			elements = append(elements, element{optional: optional})
+			pattern = rest
+		case ')':
+			if !nested {
+				return elements, pattern, nil
+			}
+			flush()
+			return elements, pattern[1:], nil
+		case ']':
+			return nil, "", fmt.Errorf("unexpected ']' without matching '['")
+		default:
+			literal.WriteByte(pattern[0])
+			pattern = pattern[1:]
+		}
+	}
+
+	if nested {
+		return nil, "", fmt.Errorf("unbalanced '(', optional group is not closed")
+	}
+	flush()
+	return elements, "", nil
+}
+
+// parseToken parses `[name]` or `[name<regexp>]` at the start of pattern.
+func parseToken(pattern string) (element, string, error) {
+	end := strings.IndexAny(pattern, "<]")
+	if end == -1 {
+		return element{}, "", fmt.Errorf("unterminated token %q", pattern)
+	}
+
+	name := pattern[1:end]
+	if !tokenNameRegexp.MatchString(name) {
+		return element{}, "", fmt.Errorf("invalid token name %q", name)
+	}
+
+	if pattern[end] == ']' {
+		if _, ok := tokenRegexps[name]; !ok && name != TokenFolderItegRev && name != TokenFileItegRev {
+			return element{}, "", fmt.Errorf("unknown token [%s], custom tokens must define a regular expression, e.g. [%s<.+>]", name, name)
+		}
+		return element{token: name}, pattern[end+1:], nil
+	}
+
+	regexpEnd := strings.Index(pattern[end:], ">]")
+	if regexpEnd == -1 {
+		return element{}, "", fmt.Errorf("unterminated custom token [%s<...>]", name)
+	}
+	custom := pattern[end+1 : end+regexpEnd]
+	if _, err := regexp.Compile(custom); err != nil {
+		return element{}, "", fmt.Errorf("invalid regexp of custom token [%s]: %w", name, err)
+	}
+
+	return element{token: name, custom: custom}, pattern[end+regexpEnd+2:], nil
+}
+
+func (p *Pattern) tokenRegexp(e element) string {
+	switch {
+	case e.custom != "":
+		return e.custom
+	case e.token == TokenFolderItegRev:
+		return p.folderItegRev
+	case e.token == TokenFileItegRev:
+		return p.fileItegRev
+	default:
+		return tokenRegexps[e.token]
+	}
+}
+
+// compile generates the regexp. Go regexp has no back-references, so every token occurrence gets its own group;
+// values in fixed replace later occurrences of a token with the literal value of its first occurrence.
+func (p *Pattern) compile(fixed map[string]string) (*regexp.Regexp, []string, error) {
+	var groupTokens []string
+	seen := map[string]bool{}
+
+	var generate func(elements []element) string
+	generate = func(elements []element) string {
+		var sb strings.Builder
+		for _, e := range elements {
+			switch {
+			case e.optional != nil:
+				sb.WriteString("(?:" + generate(e.optional) + ")?")
+			case e.token != "":
+				expr := p.tokenRegexp(e)
+				if value, ok := fixed[e.token]; ok && seen[e.token] {
+					expr = regexp.QuoteMeta(value)
+				}
+				seen[e.token] = true
+				sb.WriteString(fmt.Sprintf("(?P<t%d>(?:%s))", len(groupTokens), expr))
+				groupTokens = append(groupTokens, e.token)
+			default:
+				sb.WriteString(regexp.QuoteMeta(e.literal))
+			}
+		}
+		return sb.String()
+	}
+
+	expr := "^" + generate(p.elements) + "$"
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to compile path pattern %q: %w", p.Source, err)
+	}
+	return re, groupTokens, nil
+}
+
+// Validate checks that pathPattern compiles and contains the tokens Artifactory needs to identify a module: [org]
+// or [orgPath], [module] and [baseRev].
+func Validate(pathPattern string) error {
+	p, err := Compile(pathPattern, "", "")
+	if err != nil {
+		return err
+	}
+
+	tokens := map[string]bool{}
+	for _, token := range p.Tokens() {
+		tokens[token] = true
+	}
+
+	var missing []string
+	if !tokens[TokenOrg] && !tokens[TokenOrgPath] {
+		missing = append(missing, "[org] or [orgPath]")
+	}
+	for _, token := range []string{TokenModule, TokenBaseRev} {
+		if !tokens[token] {
+			missing = append(missing, "["+token+"]")
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("path pattern %q is missing mandatory tokens: %s", pathPattern, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// Regexp returns the regular expression the pattern compiles to.
+func (p *Pattern) Regexp() string {
+	return p.regexp.String()
+}
+
+// Tokens returns the names of the tokens used in the pattern, in order of first appearance.
+func (p *Pattern) Tokens() []string {
+	var tokens []string
+	seen := map[string]bool{}
+	for _, token := range p.groupTokens {
+		if !seen[token] {
+			seen[token] = true
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+// Parse matches path against the pattern and returns the values of the matched tokens. Tokens of optional groups
+// that are not present in the path are omitted. The second return value is false if the path does not match.
+func (p *Pattern) Parse(path string) (map[string]string, bool) {
+	path = strings.TrimPrefix(path, "/")
+
+	tokens, consistent, ok := match(p.regexp, p.groupTokens, path)
+	if !ok {
+		return nil, false
+	}
+	if consistent {
+		return tokens, true
+	}
+
+	// a repeated token matched different values, retry with later occurrences fixed to the first one
+	re, groupTokens, err := p.compile(tokens)
+	if err != nil {
+		return nil, false
+	}
+	tokens, consistent, ok = match(re, groupTokens, path)
+	if !ok || !consistent {
+		return nil, false
+	}
+	return tokens, true
+}
+
+func match(re *regexp.Regexp, groupTokens []string, path string) (map[string]string, bool, bool) {
+	submatches := re.FindStringSubmatchIndex(path)
+	if submatches == nil {
+		return nil, false, false
+	}
+
+	tokens := map[string]string{}
+	consistent := true
+	for i, name := range re.SubexpNames() {
+		if !strings.HasPrefix(name, "t") || submatches[2*i] < 0 {
+			continue
+		}
+		var index int
+		if _, err := fmt.Sscanf(name, "t%d", &index); err != nil {
+			continue
+		}
+		token := groupTokens[index]
+		value := path[submatches[2*i]:submatches[2*i+1]]
+		if existing, ok := tokens[token]; ok {
+			if existing != value {
+				consistent = false
+			}
+			continue
+		}
+		tokens[token] = value
+	}
+	return tokens, consistent, true
+}
+
+// Build generates a path from token values. `org` and `orgPath` are interchangeable, dots and slashes are
+// converted as needed. Optional groups are only rendered when all of their tokens have a value.
+func (p *Pattern) Build(tokens map[string]string) (string, error) {
+	lookup := func(token string) (string, bool) {
+		if value, ok := tokens[token]; ok && value != "" {
+			return value, true
+		}
+		switch token {
+		case TokenOrgPath:
+			if value, ok := tokens[TokenOrg]; ok && value != "" {
+				return strings.ReplaceAll(value, ".", "/"), true
+			}
+		case TokenOrg:
+			if value, ok := tokens[TokenOrgPath]; ok && value != "" {
+				return strings.ReplaceAll(value, "/", "."), true
+			}
+		}
+		return "", false
+	}
+
+	var render func(elements []element) (string, error)
+	render = func(elements []element) (string, error) {
+		var sb strings.Builder
+		for _, e := range elements {
+			switch {
+			case e.optional != nil:
+				if value, err := render(e.optional); err == nil {
+					sb.WriteString(value)
+				}
+			case e.token != "":
+				value, ok := lookup(e.token)
+				if !ok {
+					return "", fmt.Errorf("token [%s] is required", e.token)
+				}
+				sb.WriteString(value)
+			default:
+				sb.WriteString(e.literal)
+			}
+		}
+		return sb.String(), nil
+	}
+
+	return render(p.elements)
+}
@@ -0,0 +1,226 @@
+package repolayout_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/repolayout"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	maven2Pattern        = "[orgPath]/[module]/[baseRev](-[folderItegRev])/[module]-[baseRev](-[fileItegRev])(-[classifier]).[ext]"
+	maven2FolderItegRev  = "SNAPSHOT"
+	maven2FileItegRev    = "SNAPSHOT|(?:(?:[0-9]{8}.[0-9]{6})-(?:[0-9]+))"
+	ivyPattern           = "[org]/[module]/[baseRev](-[folderItegRev])/[type]s/[module](-[classifier])-[baseRev](-[fileItegRev]).[ext]"
+	ivyItegRev           = `\d{14}`
+	customTokenPattern   = "[orgPath]/[module]/[channel<stable|testing>]/[baseRev]/[module]-[baseRev].[ext]"
+	unbalancedPattern    = "[orgPath]/[module]/[baseRev](-[folderItegRev]/[module]-[baseRev].[ext]"
+	unbalancedEndPattern = "[orgPath]/[module]/[baseRev])/[module]-[baseRev].[ext]"
+)
+
+func TestCompile(t *testing.T) {
+	testCases := []struct {
+		name    string
+		pattern string
+		isError bool
+	}{
+		{"maven-2", maven2Pattern, false},
+		{"ivy", ivyPattern, false},
+		{"custom token", customTokenPattern, false},
+		{"unclosed optional group", unbalancedPattern, true},
+		{"unopened optional group", unbalancedEndPattern, true},
+		{"unterminated token", "[orgPath]/[module", true},
+		{"unknown token", "[orgPath]/[foo]/[baseRev].[ext]", true},
+		{"invalid custom token regexp", "[orgPath]/[foo<(>]/[baseRev].[ext]", true},
+		{"stray bracket", "[orgPath]/module]/[baseRev].[ext]", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := repolayout.Compile(tc.pattern, "", "")
+			assert.Equal(t, tc.isError, err != nil, "error: %v", err)
+		})
+	}
+
+	_, err := repolayout.Compile(maven2Pattern, "(", "")
+	assert.Error(t, err)
+}
+
+func TestPattern_Tokens(t *testing.T) {
+	pattern, err := repolayout.Compile(maven2Pattern, maven2FolderItegRev, maven2FileItegRev)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"orgPath", "module", "baseRev", "folderItegRev", "fileItegRev", "classifier", "ext"}, pattern.Tokens())
+}
+
+func TestPattern_Parse(t *testing.T) {
+	maven2, err := repolayout.Compile(maven2Pattern, maven2FolderItegRev, maven2FileItegRev)
+	assert.NoError(t, err)
+	ivy, err := repolayout.Compile(ivyPattern, ivyItegRev, ivyItegRev)
+	assert.NoError(t, err)
+	custom, err := repolayout.Compile(customTokenPattern, "", "")
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		pattern  *repolayout.Pattern
+		path     string
+		expected map[string]string
+	}{
+		{
+			name:    "maven release",
+			pattern: maven2,
+			path:    "org/acme/foo/1.0/foo-1.0.jar",
+			expected: map[string]string{
+				"orgPath": "org/acme", "module": "foo", "baseRev": "1.0", "ext": "jar",
+			},
+		},
+		{
+			name:    "maven unique snapshot with classifier",
+			pattern: maven2,
+			path:    "/org/acme/foo/1.0-SNAPSHOT/foo-1.0-20230101.123456-1-sources.jar",
+			expected: map[string]string{
+				"orgPath": "org/acme", "module": "foo", "baseRev": "1.0", "folderItegRev": "SNAPSHOT",
+				"fileItegRev": "20230101.123456-1", "classifier": "sources", "ext": "jar",
+			},
+		},
+		{
+			name:    "maven module with dash",
+			pattern: maven2,
+			path:    "org/acme/foo-bar/2.1/foo-bar-2.1.tar.gz",
+			expected: map[string]string{
+				"orgPath": "org/acme", "module": "foo-bar", "baseRev": "2.1", "ext": "tar.gz",
+			},
+		},
+		{
+			name:    "maven module mismatch",
+			pattern: maven2,
+			path:    "org/acme/foo/1.0/bar-1.0.jar",
+		},
+		{
+			name:    "ivy integration revision",
+			pattern: ivy,
+			path:    "acme/foo/1.0-20230101123456/jars/foo-1.0-20230101123456.jar",
+			expected: map[string]string{
+				"org": "acme", "module": "foo", "baseRev": "1.0", "folderItegRev": "20230101123456",
+				"type": "jar", "fileItegRev": "20230101123456", "ext": "jar",
+			},
+		},
+		{
+			name:    "custom token",
+			pattern: custom,
+			path:    "org/acme/foo/stable/1.0/foo-1.0.zip",
+			expected: map[string]string{
+				"orgPath": "org/acme", "module": "foo", "channel": "stable", "baseRev": "1.0", "ext": "zip",
+			},
+		},
+		{
+			name:    "custom token mismatch",
+			pattern: custom,
+			path:    "org/acme/foo/nightly/1.0/foo-1.0.zip",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokens, ok := tc.pattern.Parse(tc.path)
+			assert.Equal(t, tc.expected != nil, ok)
+			assert.Equal(t, tc.expected, tokens)
+		})
+	}
+}
+
+func TestPattern_Build(t *testing.T) {
+	maven2, err := repolayout.Compile(maven2Pattern, maven2FolderItegRev, maven2FileItegRev)
+	assert.NoError(t, err)
+
+	path, err := maven2.Build(map[string]string{
+		"org": "org.acme", "module": "foo", "baseRev": "1.0", "ext": "jar",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "org/acme/foo/1.0/foo-1.0.jar", path)
+
+	path, err = maven2.Build(map[string]string{
+		"orgPath": "org/acme", "module": "foo", "baseRev": "1.0", "folderItegRev": "SNAPSHOT",
+		"fileItegRev": "SNAPSHOT", "classifier": "sources", "ext": "jar",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "org/acme/foo/1.0-SNAPSHOT/foo-1.0-SNAPSHOT-sources.jar", path)
+
+	_, err = maven2.Build(map[string]string{"orgPath": "org/acme", "baseRev": "1.0", "ext": "jar"})
+	assert.EqualError(t, err, "token [module] is required")
+
+	tokens, ok := maven2.Parse(path)
+	assert.True(t, ok)
+	rebuilt, err := maven2.Build(tokens)
+	assert.NoError(t, err)
+	assert.Equal(t, path, rebuilt)
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		pattern string
+		isError bool
+	}{
+		{"maven-2", maven2Pattern, false},
+		{"ivy", ivyPattern, false},
+		{"descriptor", "[org]/[module]/ivy-[baseRev](-[fileItegRev]).xml", false},
+		{"missing org", "[module]/[baseRev]/[module]-[baseRev].[ext]", true},
+		{"missing module", "[orgPath]/[baseRev]/[baseRev].[ext]", true},
+		{"missing baseRev", "[orgPath]/[module]/[module].[ext]", true},
+		{"unbalanced", unbalancedPattern, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := repolayout.Validate(tc.pattern)
+			assert.Equal(t, tc.isError, err != nil, "error: %v", err)
+		})
+	}
+}
+
+func TestValidateRevisionRegexp(t *testing.T) {
+	testCases := []struct {
+		expr     string
+		severity diag.Severity
+		count    int
+	}{
+		{maven2FileItegRev, diag.Error, 0},
+		{ivyItegRev, diag.Error, 0},
+		{"", diag.Error, 1},
+		{`(\d+)-SNAPSHOT`, diag.Warning, 1},
+		{`SNAPSHOT(?!-RC)`, diag.Warning, 1},
+		{`\d++`, diag.Warning, 1},
+		{`(a)\1`, diag.Warning, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			diags := repolayout.ValidateRevisionRegexp(tc.expr, cty.Path{})
+			assert.Len(t, diags, tc.count)
+			for _, d := range diags {
+				assert.Equal(t, tc.severity, d.Severity, d.Detail)
+			}
+		})
+	}
+}
+
+func TestGoRevisionRegexp(t *testing.T) {
+	for _, expr := range []string{"", maven2FileItegRev, ivyItegRev} {
+		goExpr, ok := repolayout.GoRevisionRegexp(expr)
+		assert.True(t, ok, expr)
+		assert.Equal(t, expr, goExpr)
+	}
+
+	goExpr, ok := repolayout.GoRevisionRegexp(`SNAPSHOT(?!-RC)`)
+	assert.False(t, ok)
+	assert.Equal(t, repolayout.FallbackRevisionRegexp, goExpr)
+
+	pattern, err := repolayout.Compile("[orgPath]/[module]/[baseRev](-[folderItegRev])/[module]-[baseRev](-[fileItegRev]).[ext]", goExpr, goExpr)
+	assert.NoError(t, err)
+	tokens, matches := pattern.Parse("org/acme/foo/1.0-SNAPSHOT/foo-1.0-SNAPSHOT.jar")
+	assert.True(t, matches)
+	assert.Equal(t, "SNAPSHOT", tokens[repolayout.TokenFolderItegRev])
+}
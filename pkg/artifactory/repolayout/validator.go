@@ -0,0 +1,81 @@
+package repolayout
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// ValidatePathPattern is a schema.SchemaValidateDiagFunc for `artifact_path_pattern` and `descriptor_path_pattern`.
+func ValidatePathPattern(value interface{}, path cty.Path) diag.Diagnostics {
+	pattern, ok := value.(string)
+	if !ok {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid layout path pattern",
+				Detail:        "expected type of value to be string",
+				AttributePath: path,
+			},
+		}
+	}
+
+	if err := Validate(pattern); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid layout path pattern",
+				Detail:        err.Error(),
+				AttributePath: path,
+			},
+		}
+	}
+
+	return nil
+}
+
+// ValidateRevisionRegexp is a schema.SchemaValidateDiagFunc for the integration revision regexps of a layout. Capturing
+// groups are not an error but shift the groups Artifactory relies on, so they are reported as a warning.
+//
+// Artifactory compiles the regexps as Java regular expressions. Lookarounds, possessive quantifiers or backreferences
+// are valid there but rejected by Go, so an expression Go can't compile is only reported as a warning.
+func ValidateRevisionRegexp(value interface{}, path cty.Path) diag.Diagnostics {
+	expr, ok := value.(string)
+	if !ok || expr == "" {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid integration revision regexp",
+				Detail:        "expected a non-empty string, use '.*' if not applicable",
+				AttributePath: path,
+			},
+		}
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       "Integration revision regexp can't be verified",
+				Detail:        fmt.Sprintf("%q is not a valid Go regular expression, make sure it's a valid Java one: %s", expr, err),
+				AttributePath: path,
+			},
+		}
+	}
+
+	if re.NumSubexp() > 0 {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       "Integration revision regexp contains capturing groups",
+				Detail:        fmt.Sprintf("%q contains %d capturing group(s), use non-capturing groups '(?:...)' instead", expr, re.NumSubexp()),
+				AttributePath: path,
+			},
+		}
+	}
+
+	return nil
+}
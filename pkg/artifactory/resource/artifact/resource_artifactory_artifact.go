@@ -0,0 +1,418 @@
+package artifact
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/datasource"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+)
+
+const (
+	StorageEndpoint = "artifactory/api/storage/%s/%s"
+	ArtifactPath    = "artifactory/%s/%s"
+)
+
+var artifactPathRegex = regexp.MustCompile(`^/|/$`)
+
+// checksums of the content to deploy, in the format of the checksum headers of Artifactory.
+type checksums struct {
+	Md5    string
+	Sha1   string
+	Sha256 string
+	Size   int64
+}
+
+func computeChecksums(r io.Reader) (checksums, error) {
+	md5Hash, sha1Hash, sha256Hash := md5.New(), sha1.New(), sha256.New()
+	size, err := io.Copy(io.MultiWriter(md5Hash, sha1Hash, sha256Hash), r)
+	if err != nil {
+		return checksums{}, err
+	}
+	return checksums{
+		Md5:    hex.EncodeToString(md5Hash.Sum(nil)),
+		Sha1:   hex.EncodeToString(sha1Hash.Sum(nil)),
+		Sha256: hex.EncodeToString(sha256Hash.Sum(nil)),
+		Size:   size,
+	}, nil
+}
+
+// content opens the content to deploy, either the `source` file or the decoded `content_base64`.
+type content struct {
+	source        string
+	contentBase64 string
+}
+
+func (c content) open() (io.ReadCloser, error) {
+	if c.source != "" {
+		return os.Open(c.source)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(c.contentBase64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode content_base64: %s", err)
+	}
+	return io.NopCloser(bytes.NewReader(decoded)), nil
+}
+
+func (c content) checksums() (checksums, error) {
+	r, err := c.open()
+	if err != nil {
+		return checksums{}, err
+	}
+	defer r.Close()
+	return computeChecksums(r)
+}
+
+// EscapeProperty escapes the characters with a special meaning in the `properties` parameter of Artifactory. Commas
+// are kept, as they separate the values of a multi-value property.
+func EscapeProperty(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `|`, `\|`, `=`, `\=`, `;`, `\;`).Replace(value)
+}
+
+func ResourceArtifactoryArtifact() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceArtifactCreate,
+		ReadContext:   resourceArtifactRead,
+		UpdateContext: resourceArtifactUpdate,
+		DeleteContext: resourceArtifactDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceArtifactImport,
+		},
+
+		CustomizeDiff: artifactContentDiff,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: repository.RepoKeyValidator,
+				Description:  "Key of the repository to deploy the artifact to.",
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+					validation.StringIsNotEmpty,
+					validation.StringDoesNotMatch(artifactPathRegex, "path must not start or end with a slash"),
+				)),
+				Description: "Path of the artifact in the repository, e.g. `tools/installer-1.0.zip`.",
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"source", "content_base64"},
+				Description:  "Path of the local file to deploy.",
+			},
+			"content_base64": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsBase64),
+				Description:      "Base64 encoded content to deploy, e.g. from `filebase64()` or `base64encode()`.",
+			},
+			"properties": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Properties of the artifact. The values of a multi-value property are separated by commas, e.g. `{ os = \"linux,darwin\" }`.",
+			},
+			"sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA256 checksum of the artifact. A change of the local content, or of the artifact in Artifactory, deploys the artifact again.",
+			},
+			"sha1": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA1 checksum of the artifact.",
+			},
+			"md5": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "MD5 checksum of the artifact.",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of the artifact, in bytes.",
+			},
+			"download_uri": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URI to download the artifact from.",
+			},
+			"checksum_deployed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "`true` if the last deploy only sent the checksums, as Artifactory already stored the content.",
+			},
+		},
+		Description: "Deploys an artifact to a repository, from a local file or base64 encoded content. The artifact is deployed " +
+			"with its checksums first, so the content is only uploaded when Artifactory doesn't store it yet.",
+	}
+}
+
+func getContent(d *schema.ResourceData) content {
+	return content{
+		source:        d.Get("source").(string),
+		contentBase64: d.Get("content_base64").(string),
+	}
+}
+
+// artifactContentDiff compares the checksum of the local content with the one of the artifact, so changing the content
+// deploys the artifact again.
+func artifactContentDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("source") || !diff.NewValueKnown("content_base64") {
+		return diff.SetNewComputed("sha256")
+	}
+
+	c := content{
+		source:        diff.Get("source").(string),
+		contentBase64: diff.Get("content_base64").(string),
+	}
+	if c.source == "" && c.contentBase64 == "" {
+		return nil
+	}
+
+	sums, err := c.checksums()
+	if err != nil {
+		return err
+	}
+	if diff.Get("sha256").(string) != sums.Sha256 {
+		return diff.SetNew("sha256", sums.Sha256)
+	}
+	return nil
+}
+
+// deployArtifact deploys the content of the resource, and returns true if the checksum deploy succeeded.
+func deployArtifact(ctx context.Context, d *schema.ResourceData, m interface{}) (bool, error) {
+	c := getContent(d)
+	sums, err := c.checksums()
+	if err != nil {
+		return false, err
+	}
+	return deployContent(ctx, m.(utilsdk.ProvderMetadata).Client, d.Get("repository").(string), d.Get("path").(string), c, sums)
+}
+
+// deployContent deploys the artifact with its checksums only, and uploads the content if Artifactory doesn't store it
+// yet. It returns true if the checksum deploy succeeded.
+func deployContent(ctx context.Context, client *resty.Client, repoKey, path string, c content, sums checksums) (bool, error) {
+	url := fmt.Sprintf(ArtifactPath, repoKey, path)
+	checksumHeaders := map[string]string{
+		"X-Checksum-Md5":    sums.Md5,
+		"X-Checksum-Sha1":   sums.Sha1,
+		"X-Checksum-Sha256": sums.Sha256,
+	}
+
+	resp, err := client.R().
+		SetHeaders(checksumHeaders).
+		SetHeader("X-Checksum-Deploy", "true").
+		Put(url)
+	if err == nil {
+		return true, nil
+	}
+	if resp == nil || resp.StatusCode() != http.StatusNotFound {
+		return false, fmt.Errorf("failed to deploy %s to repository %s: %s", path, repoKey, err)
+	}
+	tflog.Debug(ctx, "checksum not found in Artifactory, uploading the content", map[string]interface{}{"path": path, "sha1": sums.Sha1})
+
+	body, err := c.open()
+	if err != nil {
+		return false, err
+	}
+	defer body.Close()
+
+	_, err = client.R().
+		SetHeaders(checksumHeaders).
+		SetHeader("Content-Type", "application/octet-stream").
+		SetContentLength(true).
+		SetBody(body).
+		Put(url)
+	if err != nil {
+		return false, fmt.Errorf("failed to upload %s to repository %s: %s", path, repoKey, err)
+	}
+	return false, nil
+}
+
+// SplitPropertyValues splits the comma separated values of the multi-value properties of the artifact, e.g.
+// `{ os = "linux,darwin" }`.
+func SplitPropertyValues(properties map[string]interface{}) map[string][]string {
+	values := map[string][]string{}
+	for name, value := range properties {
+		values[name] = strings.Split(value.(string), ",")
+	}
+	return values
+}
+
+func getProperties(d *schema.ResourceData) map[string][]string {
+	return SplitPropertyValues(d.Get("properties").(map[string]interface{}))
+}
+
+// updateProperties sets the properties to properties, and deletes the properties in removed.
+func updateProperties(m interface{}, repoKey, path string, properties map[string][]string, removed []string) error {
+	client := m.(utilsdk.ProvderMetadata).Client
+	url := fmt.Sprintf(StorageEndpoint, repoKey, path)
+
+	if err := DeleteItemProperties(client, repoKey, path, removed, false); err != nil {
+		return err
+	}
+
+	if len(properties) > 0 {
+		_, err := client.R().
+			SetQueryParam("properties", FormatMultiValueProperties(properties)).
+			SetQueryParam("recursive", "0").
+			Put(url)
+		if err != nil {
+			return fmt.Errorf("failed to set properties of %s in repository %s: %s", path, repoKey, err)
+		}
+	}
+	return nil
+}
+
+func resourceArtifactCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	repoKey := d.Get("repository").(string)
+	path := d.Get("path").(string)
+
+	checksumDeployed, err := deployArtifact(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", repoKey, path))
+
+	if err := updateProperties(m, repoKey, path, getProperties(d), nil); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("checksum_deployed", checksumDeployed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceArtifactRead(ctx, d, m)
+}
+
+func resourceArtifactRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(utilsdk.ProvderMetadata).Client
+	repoKey := d.Get("repository").(string)
+	path := d.Get("path").(string)
+	url := fmt.Sprintf(StorageEndpoint, repoKey, path)
+
+	fileInfo := datasource.FileInfo{}
+	resp, err := client.R().SetResult(&fileInfo).Get(url)
+	if err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// only the properties managed by the resource are read, Artifactory and other tools add their own
+	remoteProperties := struct {
+		Properties map[string][]string `json:"properties"`
+	}{}
+	resp, err = client.R().
+		SetResult(&remoteProperties).
+		SetQueryParam("properties", "").
+		Get(url)
+	if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+		return diag.FromErr(err)
+	}
+	properties := map[string]string{}
+	for name := range d.Get("properties").(map[string]interface{}) {
+		if values, ok := remoteProperties.Properties[name]; ok {
+			properties[name] = strings.Join(values, ",")
+		}
+	}
+
+	setValue := utilsdk.MkLens(d)
+	setValue("sha256", fileInfo.Checksums.Sha256)
+	setValue("sha1", fileInfo.Checksums.Sha1)
+	setValue("md5", fileInfo.Checksums.Md5)
+	setValue("size", fileInfo.Size)
+	setValue("download_uri", fileInfo.DownloadUri)
+	errors := setValue("properties", properties)
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed to pack artifact %q", errors)
+	}
+
+	return nil
+}
+
+func resourceArtifactUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	repoKey := d.Get("repository").(string)
+	path := d.Get("path").(string)
+
+	if d.HasChange("sha256") {
+		checksumDeployed, err := deployArtifact(ctx, d, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("checksum_deployed", checksumDeployed); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("properties") {
+		old, new := d.GetChange("properties")
+		var removed []string
+		for name := range old.(map[string]interface{}) {
+			if _, ok := new.(map[string]interface{})[name]; !ok {
+				removed = append(removed, name)
+			}
+		}
+		sort.Strings(removed)
+		if err := updateProperties(m, repoKey, path, getProperties(d), removed); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceArtifactRead(ctx, d, m)
+}
+
+func resourceArtifactDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	repoKey := d.Get("repository").(string)
+	path := d.Get("path").(string)
+
+	resp, err := m.(utilsdk.ProvderMetadata).Client.R().Delete(fmt.Sprintf(ArtifactPath, repoKey, path))
+	if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+		return diag.Errorf("failed to delete %s in repository %s: %s", path, repoKey, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceArtifactImport imports an artifact with an ID in the format `repository/path`.
+func resourceArtifactImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	repoKey, path, found := strings.Cut(d.Id(), "/")
+	if !found || repoKey == "" || path == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected repository/path", d.Id())
+	}
+
+	setValue := utilsdk.MkLens(d)
+	setValue("repository", repoKey)
+	errors := setValue("path", path)
+	if errors != nil && len(errors) > 0 {
+		return nil, fmt.Errorf("failed to pack artifact %q", errors)
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,319 @@
+package artifact
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+)
+
+const (
+	CopyEndpoint = "artifactory/api/copy/%s/%s"
+	MoveEndpoint = "artifactory/api/move/%s/%s"
+)
+
+const (
+	CopyModeCopy = "copy"
+	CopyModeMove = "move"
+)
+
+type CopyMessage struct {
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+type CopyResult struct {
+	Messages []CopyMessage `json:"messages"`
+}
+
+func (r CopyResult) String() string {
+	var messages []string
+	for _, message := range r.Messages {
+		messages = append(messages, fmt.Sprintf("%s: %s", message.Level, message.Message))
+	}
+	return strings.Join(messages, "; ")
+}
+
+var artifactCopySchema = map[string]*schema.Schema{
+	"source_repository": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: repository.RepoKeyValidator,
+		Description:  "Key of the repository to copy or move from, e.g. `libs-staging-local`.",
+	},
+	"source_path": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+			validation.StringIsNotEmpty,
+			validation.StringDoesNotMatch(artifactPathRegex, "path must not start or end with a slash"),
+		)),
+		Description: "Path of the file or folder to copy or move, e.g. `com/example/lib/1.0`.",
+	},
+	"target_repository": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: repository.RepoKeyValidator,
+		Description:  "Key of the repository to copy or move to, e.g. `libs-release-local`.",
+	},
+	"target_path": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+			validation.StringIsNotEmpty,
+			validation.StringDoesNotMatch(artifactPathRegex, "path must not start or end with a slash"),
+		)),
+		Description: "Path of the copied or moved file or folder in the target repository.",
+	},
+	"mode": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Default:          CopyModeCopy,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{CopyModeCopy, CopyModeMove}, false)),
+		Description:      "`copy` or `move`. Default to `copy`.",
+	},
+	"dry_run": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Validate the copy or move with a dry run at plan time, so a copy or move which would fail fails the plan. The dry run only runs when the resource is created, changing `dry_run` afterwards doesn't copy or move again. Default to `false`.",
+	},
+	"suppress_layouts": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     false,
+		Description: "Don't convert the paths between the layouts of the source and target repositories. Default to `false`.",
+	},
+	"fail_fast": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     false,
+		Description: "Abort the copy or move on the first failure. Default to `false`.",
+	},
+	"affected_paths": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Paths of the files copied or moved, in the target repository.",
+	},
+}
+
+func ResourceArtifactoryArtifactCopy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceArtifactCopyCreate,
+		ReadContext:   resourceArtifactCopyRead,
+		UpdateContext: resourceArtifactCopyUpdate,
+		DeleteContext: resourceArtifactCopyDelete,
+
+		CustomizeDiff: artifactCopyDryRun,
+
+		Schema: artifactCopySchema,
+		Description: "Copies or moves a file or folder to another repository or path, e.g. to promote artifacts from a staging " +
+			"repository to a release repository. The files are not copied again if the target already holds them with the " +
+			"same checksums. Deleting the resource leaves the files in the target repository.",
+	}
+}
+
+// listItemFiles returns the SHA1 checksums of the files of the item, by path relative to the item. A file has a single
+// entry with an empty path. found is false if the item doesn't exist.
+func listItemFiles(client *resty.Client, repoKey, itemPath string) (files map[string]string, found bool, err error) {
+	item := struct {
+		Children  *[]json.RawMessage `json:"children"`
+		Checksums struct {
+			Sha1 string `json:"sha1"`
+		} `json:"checksums"`
+	}{}
+	resp, err := client.R().SetResult(&item).Get(fmt.Sprintf(StorageEndpoint, repoKey, itemPath))
+	if err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to read %s in repository %s: %s", itemPath, repoKey, err)
+	}
+
+	if item.Children == nil {
+		return map[string]string{"": item.Checksums.Sha1}, true, nil
+	}
+	files, err = listRemoteFiles(client, repoKey, itemPath)
+	return files, true, err
+}
+
+func copyTargetPath(targetPath, file string) string {
+	if file == "" {
+		return targetPath
+	}
+	return targetPath + "/" + file
+}
+
+func getCopyParams(data *utilsdk.ResourceData) map[string]string {
+	return map[string]string{
+		"to":              fmt.Sprintf("/%s/%s", data.GetString("target_repository", false), data.GetString("target_path", false)),
+		"suppressLayouts": boolParam(data.GetBool("suppress_layouts", false)),
+		"failFast":        boolParam(data.GetBool("fail_fast", false)),
+	}
+}
+
+func copyArtifacts(client *resty.Client, mode, sourceRepoKey, sourcePath string, params map[string]string, dryRun bool) error {
+	endpoint := CopyEndpoint
+	if mode == CopyModeMove {
+		endpoint = MoveEndpoint
+	}
+
+	result := CopyResult{}
+	_, err := client.R().
+		SetResult(&result).
+		SetError(&result).
+		SetQueryParams(params).
+		SetQueryParam("dry", boolParam(dryRun)).
+		Post(fmt.Sprintf(endpoint, sourceRepoKey, sourcePath))
+	if err != nil {
+		message := result.String()
+		if message == "" {
+			message = err.Error()
+		}
+		return fmt.Errorf("failed to %s %s in repository %s to %s: %s", mode, sourcePath, sourceRepoKey, params["to"], message)
+	}
+	return nil
+}
+
+// artifactCopyDryRun copies or moves with a dry run when the resource is created, so a copy or move which would fail
+// fails the plan.
+func artifactCopyDryRun(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Id() != "" || !diff.Get("dry_run").(bool) {
+		return nil
+	}
+	for _, key := range []string{"source_repository", "source_path", "target_repository", "target_path"} {
+		if !diff.NewValueKnown(key) {
+			tflog.Debug(ctx, "skipping the dry run, the source or target is unknown")
+			return nil
+		}
+	}
+
+	params := map[string]string{
+		"to":              fmt.Sprintf("/%s/%s", diff.Get("target_repository"), diff.Get("target_path")),
+		"suppressLayouts": boolParam(diff.Get("suppress_layouts").(bool)),
+		"failFast":        boolParam(diff.Get("fail_fast").(bool)),
+	}
+	client := m.(utilsdk.ProvderMetadata).Client
+	return copyArtifacts(client, diff.Get("mode").(string), diff.Get("source_repository").(string), diff.Get("source_path").(string), params, true)
+}
+
+func resourceArtifactCopyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	data := &utilsdk.ResourceData{ResourceData: d}
+	client := m.(utilsdk.ProvderMetadata).Client
+	mode := data.GetString("mode", false)
+	sourceRepoKey := data.GetString("source_repository", false)
+	sourcePath := data.GetString("source_path", false)
+	targetRepoKey := data.GetString("target_repository", false)
+	targetPath := data.GetString("target_path", false)
+
+	source, found, err := listItemFiles(client, sourceRepoKey, sourcePath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	target, targetFound, err := listItemFiles(client, targetRepoKey, targetPath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		// the files were already moved, e.g. by an apply whose state was lost
+		if mode != CopyModeMove || !targetFound {
+			return diag.Errorf("%s doesn't exist in repository %s", sourcePath, sourceRepoKey)
+		}
+		tflog.Info(ctx, "source missing and target present, the files were already moved", map[string]interface{}{
+			"source": sourcePath,
+			"target": targetPath,
+		})
+		source = target
+	}
+
+	var affectedPaths []string
+	copied := true
+	for file, sha1 := range source {
+		affectedPaths = append(affectedPaths, copyTargetPath(targetPath, file))
+		if target[file] != sha1 {
+			copied = false
+		}
+	}
+	sort.Strings(affectedPaths)
+
+	switch {
+	case !found:
+		// the files are already in the target
+	case copied && mode == CopyModeCopy:
+		tflog.Info(ctx, "target already holds the files with the same checksums, skipping the copy", map[string]interface{}{
+			"source": sourcePath,
+			"target": targetPath,
+		})
+	default:
+		if err := copyArtifacts(client, mode, sourceRepoKey, sourcePath, getCopyParams(data), false); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s:%s/%s", sourceRepoKey, sourcePath, targetRepoKey, targetPath))
+
+	setValue := utilsdk.MkLens(d)
+	errors := setValue("affected_paths", affectedPaths)
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed to pack artifact copy %q", errors)
+	}
+
+	return nil
+}
+
+// resourceArtifactCopyRead removes the copy from the state if a copied file is missing in the target repository, so
+// it's copied again. A move is kept in the state with a warning instead, as the source no longer holds the files.
+func resourceArtifactCopyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(utilsdk.ProvderMetadata).Client
+	targetRepoKey := d.Get("target_repository").(string)
+
+	for _, path := range utilsdk.CastToStringArr(d.Get("affected_paths").([]interface{})) {
+		resp, err := client.R().Head(fmt.Sprintf(ArtifactPath, targetRepoKey, path))
+		if err != nil {
+			if resp != nil && resp.StatusCode() == http.StatusNotFound {
+				if d.Get("mode").(string) == CopyModeMove {
+					return diag.Diagnostics{{
+						Severity: diag.Warning,
+						Summary:  "Moved file missing in the target repository",
+						Detail: fmt.Sprintf("%s is missing in repository %s. The files can't be moved again, as they were "+
+							"removed from the source, so the move is kept in the state.", path, targetRepoKey),
+					}}
+				}
+				tflog.Info(ctx, "copied file missing in the target repository", map[string]interface{}{"path": path})
+				d.SetId("")
+				return nil
+			}
+			return diag.Errorf("failed to read %s in repository %s: %s", path, targetRepoKey, err)
+		}
+	}
+
+	return nil
+}
+
+// resourceArtifactCopyUpdate only updates dry_run, all the other arguments force a new copy.
+func resourceArtifactCopyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceArtifactCopyRead(ctx, d, m)
+}
+
+func resourceArtifactCopyDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,295 @@
+package artifact_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/artifact"
+	"github.com/jfrog/terraform-provider-shared/client"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+// lastModified returns the last modified date of the file, to check if it has been copied again.
+func lastModified(t *testing.T, repoKey, path string) string {
+	item := struct {
+		LastModified string `json:"lastModified"`
+	}{}
+	_, err := acctest.GetTestResty(t).R().
+		SetResult(&item).
+		Get(fmt.Sprintf(artifact.StorageEndpoint, repoKey, path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return item.LastModified
+}
+
+func TestAccArtifactCopy(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("copy-", "artifactory_artifact_copy")
+	_, _, stagingName := testutil.MkNames("tf-staging-", "artifactory_local_generic_repository")
+	_, _, releaseName := testutil.MkNames("tf-release-", "artifactory_local_generic_repository")
+
+	config := utilsdk.ExecuteTemplate("TestAccArtifactCopy", `
+		resource "artifactory_local_generic_repository" "{{ .staging_name }}" {
+			key = "{{ .staging_name }}"
+		}
+
+		resource "artifactory_local_generic_repository" "{{ .release_name }}" {
+			key = "{{ .release_name }}"
+		}
+
+		resource "artifactory_artifact" "lib" {
+			repository     = artifactory_local_generic_repository.{{ .staging_name }}.key
+			path           = "com/example/lib/1.0/lib-1.0.txt"
+			content_base64 = "{{ .content }}"
+		}
+
+		resource "artifactory_artifact" "pom" {
+			repository     = artifactory_local_generic_repository.{{ .staging_name }}.key
+			path           = "com/example/lib/1.0/lib-1.0.pom"
+			content_base64 = "{{ .content }}"
+		}
+
+		resource "artifactory_artifact_copy" "{{ .name }}" {
+			source_repository = artifactory_local_generic_repository.{{ .staging_name }}.key
+			source_path       = "com/example/lib/1.0"
+			target_repository = artifactory_local_generic_repository.{{ .release_name }}.key
+			target_path       = "com/example/lib/1.0"
+			suppress_layouts  = true
+
+			depends_on = [artifactory_artifact.lib, artifactory_artifact.pom]
+		}
+	`, map[string]string{
+		"name":         name,
+		"staging_name": stagingName,
+		"release_name": releaseName,
+		"content":      base64.StdEncoding.EncodeToString([]byte("content of " + name)),
+	})
+
+	var targetLastModified string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted("artifactory_local_generic_repository."+releaseName, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "mode", "copy"),
+					resource.TestCheckResourceAttr(fqrn, "affected_paths.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "affected_paths.0", "com/example/lib/1.0/lib-1.0.pom"),
+					resource.TestCheckResourceAttr(fqrn, "affected_paths.1", "com/example/lib/1.0/lib-1.0.txt"),
+				),
+			},
+			{
+				// the target already holds the files with the same checksums, the new copy is skipped
+				PreConfig: func() {
+					targetLastModified = lastModified(t, releaseName, "com/example/lib/1.0/lib-1.0.txt")
+				},
+				Config: config,
+				Taint:  []string{fqrn},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "affected_paths.#", "2"),
+					func(_ *terraform.State) error {
+						if actual := lastModified(t, releaseName, "com/example/lib/1.0/lib-1.0.txt"); actual != targetLastModified {
+							return fmt.Errorf("expected the file not to be copied again, last modified %s, got %s", targetLastModified, actual)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig: func() {
+					_, err := acctest.GetTestResty(t).R().Delete(fmt.Sprintf("artifactory/%s/com/example/lib/1.0/lib-1.0.txt", releaseName))
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(fqrn, "affected_paths.#", "2"),
+			},
+		},
+	})
+}
+
+func TestAccArtifactCopy_move(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("copy-", "artifactory_artifact_copy")
+	_, _, stagingName := testutil.MkNames("tf-staging-", "artifactory_local_generic_repository")
+	_, _, releaseName := testutil.MkNames("tf-release-", "artifactory_local_generic_repository")
+
+	config := utilsdk.ExecuteTemplate("TestAccArtifactCopy_move", `
+		resource "artifactory_artifact_copy" "{{ .name }}" {
+			source_repository = "{{ .staging_name }}"
+			source_path       = "com/example/lib/1.0/lib-1.0.txt"
+			target_repository = "{{ .release_name }}"
+			target_path       = "com/example/lib/1.0/lib-1.0.txt"
+			mode              = "move"
+			suppress_layouts  = true
+		}
+	`, map[string]string{
+		"name":         name,
+		"staging_name": stagingName,
+		"release_name": releaseName,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.CreateRepo(t, stagingName, "local", "generic", false, false)
+			acctest.CreateRepo(t, releaseName, "local", "generic", false, false)
+			_, err := acctest.GetTestResty(t).R().
+				SetBody([]byte("content of " + name)).
+				Put(fmt.Sprintf(artifact.ArtifactPath, stagingName, "com/example/lib/1.0/lib-1.0.txt"))
+			if err != nil {
+				t.Fatal(err)
+			}
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			acctest.DeleteRepo(t, stagingName)
+			acctest.DeleteRepo(t, releaseName)
+			return nil
+		},
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "mode", "move"),
+					resource.TestCheckResourceAttr(fqrn, "affected_paths.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "affected_paths.0", "com/example/lib/1.0/lib-1.0.txt"),
+					func(_ *terraform.State) error {
+						resp, err := acctest.GetTestResty(t).R().
+							Head(fmt.Sprintf(artifact.ArtifactPath, stagingName, "com/example/lib/1.0/lib-1.0.txt"))
+						if err == nil || resp == nil || resp.StatusCode() != http.StatusNotFound {
+							return fmt.Errorf("expected the file to be moved out of repository %s", stagingName)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccArtifactCopy_dryRun(t *testing.T) {
+	_, _, name := testutil.MkNames("copy-", "artifactory_artifact_copy")
+	_, _, repoName := testutil.MkNames("tf-local-", "artifactory_local_generic_repository")
+
+	config := utilsdk.ExecuteTemplate("TestAccArtifactCopy_dryRun", `
+		resource "artifactory_artifact_copy" "{{ .name }}" {
+			source_repository = "{{ .repo_name }}"
+			source_path       = "not/a/path"
+			target_repository = "{{ .repo_name }}"
+			target_path       = "still/not/a/path"
+			mode              = "move"
+			dry_run           = true
+		}
+	`, map[string]string{
+		"name":      name,
+		"repo_name": repoName,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.CreateRepo(t, repoName, "local", "generic", false, false)
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			acctest.DeleteRepo(t, repoName)
+			return nil
+		},
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(".*failed to move not/a/path in repository.*"),
+			},
+		},
+	})
+}
+
+// movedFileServer holds lib/foo.jar in libs-release only, as if it was already moved from libs-staging, until
+// targetDeleted is set.
+type movedFileServer struct {
+	*httptest.Server
+	mu            sync.Mutex
+	targetDeleted bool
+	moves         int
+}
+
+func newMovedFileServer(t *testing.T) *movedFileServer {
+	s := &movedFileServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/artifactory/api/move/"):
+			s.moves++
+		case !s.targetDeleted && r.URL.Path == "/artifactory/api/storage/libs-release/lib/foo.jar":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"checksums":{"sha1":"0123456789abcdef"}}`))
+		case !s.targetDeleted && r.URL.Path == "/artifactory/libs-release/lib/foo.jar":
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestArtifactCopy_alreadyMoved(t *testing.T) {
+	server := newMovedFileServer(t)
+	restyClient, err := client.Build(server.URL, "terraform-provider-artifactory/test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	restyClient, err = client.AddAuth(restyClient, "", "test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta := utilsdk.ProvderMetadata{Client: restyClient}
+
+	r := artifact.ResourceArtifactoryArtifactCopy()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"source_repository": "libs-staging",
+		"source_path":       "lib/foo.jar",
+		"target_repository": "libs-release",
+		"target_path":       "lib/foo.jar",
+		"mode":              "move",
+	})
+
+	diags := r.CreateContext(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, 0, server.moves, "expected the files already in the target not to be moved again")
+	assert.Equal(t, []interface{}{"lib/foo.jar"}, d.Get("affected_paths"))
+
+	server.mu.Lock()
+	server.targetDeleted = true
+	server.mu.Unlock()
+
+	diags = r.ReadContext(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.NotEmpty(t, d.Id(), "expected the move to be kept in the state")
+}
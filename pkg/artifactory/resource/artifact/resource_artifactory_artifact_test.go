@@ -0,0 +1,105 @@
+package artifact_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/artifact"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatProperties(t *testing.T) {
+	formatted := artifact.FormatMultiValueProperties(artifact.SplitPropertyValues(map[string]interface{}{
+		"os":      "linux,darwin",
+		"release": "1.0",
+		"query":   "a=b|c;d",
+	}))
+	assert.Equal(t, `os=linux,darwin|query=a\=b\|c\;d|release=1.0`, formatted)
+}
+
+func checkArtifact(id string, request *resty.Request) (*resty.Response, error) {
+	return request.Head(fmt.Sprintf("artifactory/%s", id))
+}
+
+func TestAccArtifact(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("artifact-", "artifactory_artifact")
+	_, copyFqrn, copyName := testutil.MkNames("artifact-copy-", "artifactory_artifact")
+	_, _, repoName := testutil.MkNames("tf-local-", "artifactory_local_generic_repository")
+
+	const template = `
+		resource "artifactory_local_generic_repository" "{{ .repo_name }}" {
+			key = "{{ .repo_name }}"
+		}
+
+		resource "artifactory_artifact" "{{ .name }}" {
+			repository     = artifactory_local_generic_repository.{{ .repo_name }}.key
+			path           = "bundles/{{ .name }}.txt"
+			content_base64 = "{{ .content }}"
+			properties = {
+				release = "{{ .release }}"
+				os      = "linux,darwin"
+			}
+		}
+
+		resource "artifactory_artifact" "{{ .copy_name }}" {
+			repository     = artifactory_local_generic_repository.{{ .repo_name }}.key
+			path           = "copies/{{ .name }}.txt"
+			content_base64 = "{{ .content }}"
+
+			depends_on = [artifactory_artifact.{{ .name }}]
+		}
+	`
+	testData := map[string]string{
+		"name":      name,
+		"copy_name": copyName,
+		"repo_name": repoName,
+		"content":   base64.StdEncoding.EncodeToString([]byte("content of " + name)),
+		"release":   "1.0",
+	}
+	updatedContent := base64.StdEncoding.EncodeToString([]byte("updated content of " + name))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, checkArtifact),
+		Steps: []resource.TestStep{
+			{
+				Config: utilsdk.ExecuteTemplate("TestAccArtifact", template, testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "id", fmt.Sprintf("%s/bundles/%s.txt", repoName, name)),
+					resource.TestCheckResourceAttr(fqrn, "size", fmt.Sprintf("%d", len("content of "+name))),
+					resource.TestCheckResourceAttr(fqrn, "checksum_deployed", "false"),
+					resource.TestCheckResourceAttr(fqrn, "properties.release", "1.0"),
+					resource.TestCheckResourceAttr(fqrn, "properties.os", "linux,darwin"),
+					resource.TestCheckResourceAttrSet(fqrn, "sha256"),
+					resource.TestCheckResourceAttrSet(fqrn, "download_uri"),
+					resource.TestCheckResourceAttrPair(copyFqrn, "sha256", fqrn, "sha256"),
+					resource.TestCheckResourceAttr(copyFqrn, "checksum_deployed", "true"),
+				),
+			},
+			{
+				Config: utilsdk.ExecuteTemplate("TestAccArtifact", template, utilsdk.MergeMaps(testData, map[string]string{
+					"content": updatedContent,
+					"release": "2.0",
+				})),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "size", fmt.Sprintf("%d", len("updated content of "+name))),
+					resource.TestCheckResourceAttr(fqrn, "properties.release", "2.0"),
+					resource.TestCheckResourceAttrPair(copyFqrn, "sha256", fqrn, "sha256"),
+				),
+			},
+			{
+				ResourceName:            fqrn,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64", "properties", "checksum_deployed"},
+			},
+		},
+	})
+}
@@ -0,0 +1,355 @@
+package artifact
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/datasource"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/workerpool"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+)
+
+func ResourceArtifactoryDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDirectorySyncCreate,
+		ReadContext:   resourceDirectorySyncRead,
+		UpdateContext: resourceDirectorySyncUpdate,
+		DeleteContext: resourceDirectorySyncDelete,
+
+		CustomizeDiff: directorySyncDiff,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: repository.RepoKeyValidator,
+				Description:  "Key of the repository to synchronize the directory to.",
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringDoesNotMatch(artifactPathRegex, "path must not start or end with a slash"),
+				),
+				Description: "Folder of the repository to synchronize the directory to, e.g. `sites/docs`. Default to the root of the repository.",
+			},
+			"source_dir": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				Description:      "Local directory to synchronize. Its files are deployed recursively, with their path relative to the directory.",
+			},
+			"delete_missing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the files of the repository folder missing in the local directory. Default to `false`.",
+			},
+			"parallelism": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          4,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 32)),
+				Description:      "Number of files deployed or deleted in parallel. Default to `4`.",
+			},
+			"file_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of files in the local directory.",
+			},
+			"uploaded_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of files deployed by the last synchronization, as they were missing or different in the repository.",
+			},
+			"deleted_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of files deleted from the repository by the last synchronization.",
+			},
+			"content_digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "SHA256 digest of the paths and SHA1 checksums of the files of the repository folder. It changes " +
+					"when a file changes, locally or in the repository, which synchronizes the directory again.",
+			},
+		},
+		Description: "Synchronizes a local directory to a folder of a repository. Only the files missing or different in the " +
+			"repository are deployed, and the files missing locally are optionally deleted.",
+	}
+}
+
+// listLocalFiles returns the paths of the regular files of the directory, relative to it and slash separated.
+func listLocalFiles(dir string) ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the files of %s: %s", dir, err)
+	}
+	return paths, nil
+}
+
+// hashLocalFiles returns the checksums of the files of the directory, by relative path.
+func hashLocalFiles(dir string) (map[string]checksums, error) {
+	paths, err := listLocalFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]checksums{}
+	for _, path := range paths {
+		sums, err := content{source: filepath.Join(dir, filepath.FromSlash(path))}.checksums()
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash %s: %s", path, err)
+		}
+		files[path] = sums
+	}
+	return files, nil
+}
+
+// listRemoteFiles returns the SHA1 checksums of the files of the repository folder, by path relative to the folder. A
+// missing folder has no files.
+func listRemoteFiles(client *resty.Client, repoKey, folder string) (map[string]string, error) {
+	list := datasource.FileList{}
+	resp, err := client.R().
+		SetResult(&list).
+		SetQueryParam("list", "").
+		SetQueryParam("deep", "1").
+		SetQueryParam("listFolders", "0").
+		Get(fmt.Sprintf(StorageEndpoint, repoKey, folder))
+	if err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return map[string]string{}, nil
+		}
+		return nil, fmt.Errorf("failed to list the files of %s in repository %s: %s", folder, repoKey, err)
+	}
+
+	files := map[string]string{}
+	for _, file := range list.Files {
+		if !file.Folder {
+			files[strings.TrimPrefix(file.Uri, "/")] = file.Sha1
+		}
+	}
+	return files, nil
+}
+
+// contentDigest returns the SHA256 digest of the paths and SHA1 checksums of the files.
+func contentDigest(sha1s map[string]string) string {
+	paths := make([]string, 0, len(sha1s))
+	for path := range sha1s {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	hash := sha256.New()
+	for _, path := range paths {
+		fmt.Fprintf(hash, "%s\x00%s\n", path, sha1s[path])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func remotePath(folder, path string) string {
+	if folder == "" {
+		return path
+	}
+	return folder + "/" + path
+}
+
+// directorySyncDiff compares the digest of the local directory with the one of the repository folder read on refresh,
+// so changing a file, locally or in the repository, synchronizes the directory again. Without `delete_missing`, the
+// files of the repository folder missing locally are kept, so they're part of the expected digest.
+func directorySyncDiff(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.NewValueKnown("source_dir") {
+		for _, key := range []string{"file_count", "uploaded_count", "deleted_count", "content_digest"} {
+			if err := diff.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	files, err := hashLocalFiles(diff.Get("source_dir").(string))
+	if err != nil {
+		return err
+	}
+	sha1s := map[string]string{}
+	for path, sums := range files {
+		sha1s[path] = sums.Sha1
+	}
+
+	if diff.Id() != "" && !diff.HasChanges("repository", "path") && !diff.Get("delete_missing").(bool) {
+		remote, err := listRemoteFiles(m.(utilsdk.ProvderMetadata).Client, diff.Get("repository").(string), diff.Get("path").(string))
+		if err != nil {
+			return err
+		}
+		for path, sha1 := range remote {
+			if _, ok := sha1s[path]; !ok {
+				sha1s[path] = sha1
+			}
+		}
+	}
+
+	if diff.Get("content_digest").(string) == contentDigest(sha1s) && !diff.HasChange("delete_missing") {
+		return nil
+	}
+	if err := diff.SetNew("file_count", len(files)); err != nil {
+		return err
+	}
+	for _, key := range []string{"uploaded_count", "deleted_count", "content_digest"} {
+		if err := diff.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncTask deploys or deletes a file of the repository folder.
+type syncTask struct {
+	Path   string
+	Delete bool
+}
+
+// syncDirectory deploys the local files missing or different in the repository folder, and deletes the files missing
+// locally if requested. It returns the number of files deployed and deleted.
+func syncDirectory(ctx context.Context, d *schema.ResourceData, m interface{}) (int, int, error) {
+	data := &utilsdk.ResourceData{ResourceData: d}
+	client := m.(utilsdk.ProvderMetadata).Client
+	repoKey := data.GetString("repository", false)
+	folder := data.GetString("path", false)
+	dir := data.GetString("source_dir", false)
+
+	local, err := hashLocalFiles(dir)
+	if err != nil {
+		return 0, 0, err
+	}
+	remote, err := listRemoteFiles(client, repoKey, folder)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var uploads, deletes []syncTask
+	for path, sums := range local {
+		if remote[path] != sums.Sha1 {
+			uploads = append(uploads, syncTask{Path: path})
+		}
+	}
+	if data.GetBool("delete_missing", false) {
+		for path := range remote {
+			if _, ok := local[path]; !ok {
+				deletes = append(deletes, syncTask{Path: path, Delete: true})
+			}
+		}
+	}
+	sort.Slice(uploads, func(i, j int) bool { return uploads[i].Path < uploads[j].Path })
+	sort.Slice(deletes, func(i, j int) bool { return deletes[i].Path < deletes[j].Path })
+
+	tasks := append(uploads, deletes...)
+	_, errs := workerpool.Run(tasks, data.GetInt("parallelism", false), func(task syncTask) (struct{}, error) {
+		if !task.Delete {
+			c := content{source: filepath.Join(dir, filepath.FromSlash(task.Path))}
+			_, err := deployContent(ctx, client, repoKey, remotePath(folder, task.Path), c, local[task.Path])
+			return struct{}{}, err
+		}
+
+		resp, err := client.R().Delete(fmt.Sprintf(ArtifactPath, repoKey, remotePath(folder, task.Path)))
+		if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+			return struct{}{}, fmt.Errorf("failed to delete %s in repository %s: %s", task.Path, repoKey, err)
+		}
+		return struct{}{}, nil
+	})
+	if err := workerpool.JoinFailures("synchronize", errs); err != nil {
+		return 0, 0, err
+	}
+
+	return len(uploads), len(deletes), nil
+}
+
+func resourceDirectorySyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	repoKey := d.Get("repository").(string)
+	d.SetId(remotePath(repoKey, d.Get("path").(string)))
+
+	diags := resourceDirectorySyncUpdate(ctx, d, m)
+	if diags.HasError() {
+		d.SetId("")
+	}
+	return diags
+}
+
+// resourceDirectorySyncRead reads the digest of the files of the repository folder. The local directory is only
+// hashed when planning, by directorySyncDiff.
+func resourceDirectorySyncRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	data := &utilsdk.ResourceData{ResourceData: d}
+	client := m.(utilsdk.ProvderMetadata).Client
+	repoKey := data.GetString("repository", false)
+
+	resp, err := repository.CheckRepo(repoKey, client.R())
+	if err != nil {
+		if resp != nil && (resp.StatusCode() == http.StatusBadRequest || resp.StatusCode() == http.StatusNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	remote, err := listRemoteFiles(client, repoKey, data.GetString("path", false))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(d.Set("content_digest", contentDigest(remote)))
+}
+
+func resourceDirectorySyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	uploaded, deleted, err := syncDirectory(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	files, err := listLocalFiles(d.Get("source_dir").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setValue := utilsdk.MkLens(d)
+	setValue("file_count", len(files))
+	setValue("uploaded_count", uploaded)
+	errors := setValue("deleted_count", deleted)
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed to pack directory sync %q", errors)
+	}
+
+	return resourceDirectorySyncRead(ctx, d, m)
+}
+
+// resourceDirectorySyncDelete leaves the files in the repository, only the synchronization is removed.
+func resourceDirectorySyncDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,87 @@
+package artifact_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+)
+
+func writeFile(t *testing.T, dir, path, content string) {
+	path = filepath.Join(dir, filepath.FromSlash(path))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAccDirectorySync(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("sync-", "artifactory_directory_sync")
+	_, _, repoName := testutil.MkNames("tf-local-", "artifactory_local_generic_repository")
+	dir := t.TempDir()
+
+	config := utilsdk.ExecuteTemplate("TestAccDirectorySync", `
+		resource "artifactory_local_generic_repository" "{{ .repo_name }}" {
+			key = "{{ .repo_name }}"
+		}
+
+		resource "artifactory_directory_sync" "{{ .name }}" {
+			repository     = artifactory_local_generic_repository.{{ .repo_name }}.key
+			path           = "site"
+			source_dir     = "{{ .dir }}"
+			delete_missing = true
+		}
+	`, map[string]string{
+		"name":      name,
+		"repo_name": repoName,
+		"dir":       filepath.ToSlash(dir),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted("artifactory_local_generic_repository."+repoName, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					writeFile(t, dir, "index.html", "index of "+name)
+					writeFile(t, dir, "css/site.css", "body {}")
+					writeFile(t, dir, "docs/guide.html", "guide of "+name)
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "id", fmt.Sprintf("%s/site", repoName)),
+					resource.TestCheckResourceAttr(fqrn, "file_count", "3"),
+					resource.TestCheckResourceAttr(fqrn, "uploaded_count", "3"),
+					resource.TestCheckResourceAttr(fqrn, "deleted_count", "0"),
+					resource.TestCheckResourceAttrSet(fqrn, "content_digest"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeFile(t, dir, "index.html", "updated index of "+name)
+					if err := os.Remove(filepath.Join(dir, "docs", "guide.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "file_count", "2"),
+					resource.TestCheckResourceAttr(fqrn, "uploaded_count", "1"),
+					resource.TestCheckResourceAttr(fqrn, "deleted_count", "1"),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
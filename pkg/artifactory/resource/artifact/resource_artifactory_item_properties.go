@@ -0,0 +1,418 @@
+package artifact
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+)
+
+var itemPathRegex = regexp.MustCompile(`^$|^[^/](.*[^/])?$`)
+
+// escapePropertyValue escapes a single value of a property, commas included.
+func escapePropertyValue(value string) string {
+	return strings.ReplaceAll(EscapeProperty(value), ",", `\,`)
+}
+
+// FormatMultiValueProperties formats properties with a list of values for the `properties` parameter of Artifactory,
+// e.g. `a=1|b=2,3`.
+func FormatMultiValueProperties(properties map[string][]string) string {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var formatted []string
+	for _, key := range keys {
+		var values []string
+		for _, value := range properties[key] {
+			values = append(values, escapePropertyValue(value))
+		}
+		formatted = append(formatted, fmt.Sprintf("%s=%s", EscapeProperty(key), strings.Join(values, ",")))
+	}
+	return strings.Join(formatted, "|")
+}
+
+// GetItemProperties returns the properties of the item. found is false if the item doesn't exist.
+func GetItemProperties(client *resty.Client, repoKey, itemPath string) (properties map[string][]string, found bool, err error) {
+	url := fmt.Sprintf(StorageEndpoint, repoKey, itemPath)
+	result := struct {
+		Properties map[string][]string `json:"properties"`
+	}{}
+	resp, err := client.R().
+		SetResult(&result).
+		SetQueryParam("properties", "").
+		Get(url)
+	if err == nil {
+		return result.Properties, true, nil
+	}
+	if resp == nil || resp.StatusCode() != http.StatusNotFound {
+		return nil, false, fmt.Errorf("failed to read properties of %s in repository %s: %s", itemPath, repoKey, err)
+	}
+
+	// Artifactory answers 404 for an item without properties as well
+	resp, err = client.R().Get(url)
+	if err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to read %s in repository %s: %s", itemPath, repoKey, err)
+	}
+	return map[string][]string{}, true, nil
+}
+
+// SetItemProperties sets the properties of the item, replacing the values of the existing ones.
+func SetItemProperties(client *resty.Client, repoKey, itemPath string, properties map[string][]string, recursive bool) error {
+	if len(properties) == 0 {
+		return nil
+	}
+	_, err := client.R().
+		SetQueryParam("properties", FormatMultiValueProperties(properties)).
+		SetQueryParam("recursive", boolParam(recursive)).
+		Put(fmt.Sprintf(StorageEndpoint, repoKey, itemPath))
+	if err != nil {
+		return fmt.Errorf("failed to set properties of %s in repository %s: %s", itemPath, repoKey, err)
+	}
+	return nil
+}
+
+// DeleteItemProperties deletes the properties of the item.
+func DeleteItemProperties(client *resty.Client, repoKey, itemPath string, names []string, recursive bool) error {
+	if len(names) == 0 {
+		return nil
+	}
+	var escaped []string
+	for _, name := range names {
+		escaped = append(escaped, EscapeProperty(name))
+	}
+	resp, err := client.R().
+		SetQueryParam("properties", strings.Join(escaped, ",")).
+		SetQueryParam("recursive", boolParam(recursive)).
+		Delete(fmt.Sprintf(StorageEndpoint, repoKey, itemPath))
+	if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+		return fmt.Errorf("failed to delete properties of %s in repository %s: %s", itemPath, repoKey, err)
+	}
+	return nil
+}
+
+// boolParam formats a boolean query parameter of Artifactory.
+func boolParam(value bool) string {
+	if value {
+		return "1"
+	}
+	return "0"
+}
+
+func NewItemPropertiesResource() resource.Resource {
+	return &ArtifactoryItemPropertiesResource{}
+}
+
+type ArtifactoryItemPropertiesResource struct {
+	ProviderData utilsdk.ProvderMetadata
+}
+
+// ArtifactoryItemPropertiesResourceModel describes the Terraform resource data model to match the
+// resource schema.
+type ArtifactoryItemPropertiesResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Repository    types.String `tfsdk:"repository"`
+	Path          types.String `tfsdk:"path"`
+	Properties    types.Map    `tfsdk:"properties"`
+	Recursive     types.Bool   `tfsdk:"recursive"`
+	Authoritative types.Bool   `tfsdk:"authoritative"`
+}
+
+func (r *ArtifactoryItemPropertiesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "artifactory_item_properties"
+}
+
+func (r *ArtifactoryItemPropertiesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the properties of a file, a folder or a repository. By default, only the properties " +
+			"in the configuration are managed, the other properties of the item are left untouched.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Key of the repository of the item.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path of the file or folder in the repository, e.g. `libs/lib-1.0.jar`. Default to the repository itself.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(itemPathRegex, "must not start or end with a slash"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"properties": schema.MapAttribute{
+				MarkdownDescription: "Values of the properties, by name, e.g. `{ release = [\"ga\"], os = [\"linux\", \"darwin\"] }`.",
+				Required:            true,
+				ElementType:         types.SetType{ElemType: types.StringType},
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+					mapvalidator.ValueSetsAre(setvalidator.SizeAtLeast(1)),
+				},
+			},
+			"recursive": schema.BoolAttribute{
+				MarkdownDescription: "Set and delete the properties on the children of the folder or repository as well. Only the properties of the item itself are read. Default to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"authoritative": schema.BoolAttribute{
+				MarkdownDescription: "Delete the properties of the item not in the configuration. Default to `false`, only the properties in the configuration are managed.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *ArtifactoryItemPropertiesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(utilsdk.ProvderMetadata)
+}
+
+func (r *ArtifactoryItemPropertiesResourceModel) getProperties(ctx context.Context) (map[string][]string, diag.Diagnostics) {
+	properties := map[string][]string{}
+	if r.Properties.IsNull() || r.Properties.IsUnknown() {
+		return properties, nil
+	}
+	diags := r.Properties.ElementsAs(ctx, &properties, false)
+	return properties, diags
+}
+
+// apply sets the properties of the plan, and deletes the ones removed from the configuration. In authoritative mode,
+// the properties of the item not in the configuration are deleted as well.
+func (r *ArtifactoryItemPropertiesResource) apply(ctx context.Context, plan *ArtifactoryItemPropertiesResourceModel, removed []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := r.ProviderData.Client
+	repoKey := plan.Repository.ValueString()
+	itemPath := plan.Path.ValueString()
+	recursive := plan.Recursive.ValueBool()
+
+	properties, d := plan.getProperties(ctx)
+	if diags.Append(d...); diags.HasError() {
+		return diags
+	}
+
+	if plan.Authoritative.ValueBool() {
+		current, found, err := GetItemProperties(client, repoKey, itemPath)
+		if err != nil {
+			diags.AddError("Unable to Read Item Properties", err.Error())
+			return diags
+		}
+		if !found {
+			diags.AddError("Item Not Found", fmt.Sprintf("%s doesn't exist in repository %s", itemPath, repoKey))
+			return diags
+		}
+		for name := range current {
+			if _, ok := properties[name]; !ok {
+				removed = append(removed, name)
+			}
+		}
+	}
+
+	sort.Strings(removed)
+	if err := DeleteItemProperties(client, repoKey, itemPath, removed, recursive); err != nil {
+		diags.AddError("Unable to Delete Item Properties", err.Error())
+		return diags
+	}
+	if err := SetItemProperties(client, repoKey, itemPath, properties, recursive); err != nil {
+		diags.AddError("Unable to Set Item Properties", err.Error())
+	}
+	return diags
+}
+
+func (r *ArtifactoryItemPropertiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ArtifactoryItemPropertiesResourceModel
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Assign the resource ID for the resource in the state
+	data.Id = types.StringValue(itemId(data.Repository.ValueString(), data.Path.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactoryItemPropertiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ArtifactoryItemPropertiesResourceModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, found, err := GetItemProperties(r.ProviderData.Client, data.Repository.ValueString(), data.Path.ValueString())
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	// Treat a missing item as a signal to recreate resource
+	// and return early
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// only the managed properties are read, unless all of them are, or the resource is imported
+	properties := current
+	if !data.Authoritative.ValueBool() && !data.Properties.IsNull() {
+		managed, d := data.getProperties(ctx)
+		if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+			return
+		}
+		properties = map[string][]string{}
+		for name := range managed {
+			if values, ok := current[name]; ok {
+				properties[name] = values
+			}
+		}
+	}
+
+	value, d := types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, properties)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+	data.Properties = value
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactoryItemPropertiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ArtifactoryItemPropertiesResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, d := plan.getProperties(ctx)
+	resp.Diagnostics.Append(d...)
+	previous, d := state.getProperties(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var removed []string
+	for name := range previous {
+		if _, ok := planned[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, removed)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ArtifactoryItemPropertiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ArtifactoryItemPropertiesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	properties, d := data.getProperties(ctx)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+	var names []string
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	err := DeleteItemProperties(r.ProviderData.Client, data.Repository.ValueString(), data.Path.ValueString(), names, data.Recursive.ValueBool())
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+// ImportState imports the properties of an item with an ID in the format `repository/path`, or `repository` for the
+// properties of the repository itself. All the properties of the item are imported.
+func (r *ArtifactoryItemPropertiesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	repoKey, itemPath, _ := strings.Cut(req.ID, "/")
+	if repoKey == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: repository/path, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), itemId(repoKey, itemPath))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), repoKey)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), itemPath)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("recursive"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authoritative"), false)...)
+}
+
+func itemId(repoKey, itemPath string) string {
+	if itemPath == "" {
+		return repoKey
+	}
+	return repoKey + "/" + itemPath
+}
@@ -0,0 +1,161 @@
+package artifact_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/artifact"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatMultiValueProperties(t *testing.T) {
+	formatted := artifact.FormatMultiValueProperties(map[string][]string{
+		"os":      {"linux", "darwin"},
+		"release": {"1.0"},
+		"query":   {"a=b|c;d,e"},
+	})
+	assert.Equal(t, `os=linux,darwin|query=a\=b\|c\;d\,e|release=1.0`, formatted)
+}
+
+func TestAccItemProperties(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("props-", "artifactory_item_properties")
+	_, artifactFqrn, artifactName := testutil.MkNames("artifact-", "artifactory_artifact")
+	_, _, repoName := testutil.MkNames("tf-local-", "artifactory_local_generic_repository")
+
+	const template = `
+		resource "artifactory_local_generic_repository" "{{ .repo_name }}" {
+			key = "{{ .repo_name }}"
+		}
+
+		resource "artifactory_artifact" "{{ .artifact_name }}" {
+			repository     = artifactory_local_generic_repository.{{ .repo_name }}.key
+			path           = "libs/{{ .artifact_name }}.txt"
+			content_base64 = "{{ .content }}"
+			properties = {
+				owner = "team-a"
+			}
+		}
+
+		resource "artifactory_item_properties" "{{ .name }}" {
+			repository = artifactory_artifact.{{ .artifact_name }}.repository
+			path       = artifactory_artifact.{{ .artifact_name }}.path
+			properties = {
+				{{ .properties }}
+			}
+		}
+	`
+	testData := map[string]string{
+		"name":          name,
+		"artifact_name": artifactName,
+		"repo_name":     repoName,
+		"content":       base64.StdEncoding.EncodeToString([]byte("content of " + name)),
+		"properties": `release = ["ga"]
+				os      = ["linux", "darwin"]`,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5MuxProviderFactories,
+		CheckDestroy:             acctest.VerifyDeleted(artifactFqrn, checkArtifact),
+		Steps: []resource.TestStep{
+			{
+				Config: utilsdk.ExecuteTemplate("TestAccItemProperties", template, testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "id", fmt.Sprintf("%s/libs/%s.txt", repoName, artifactName)),
+					resource.TestCheckResourceAttr(fqrn, "properties.%", "2"),
+					resource.TestCheckResourceAttr(fqrn, "properties.release.0", "ga"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "properties.os.*", "darwin"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "properties.os.*", "linux"),
+					resource.TestCheckResourceAttr(artifactFqrn, "properties.owner", "team-a"),
+				),
+			},
+			{
+				Config: utilsdk.ExecuteTemplate("TestAccItemProperties", template, utilsdk.MergeMaps(testData, map[string]string{
+					"properties": `release = ["rc"]`,
+				})),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "properties.%", "1"),
+					resource.TestCheckResourceAttr(fqrn, "properties.release.0", "rc"),
+					checkItemProperties(t, repoName, fmt.Sprintf("libs/%s.txt", artifactName), map[string][]string{
+						"release": {"rc"},
+						"owner":   {"team-a"},
+					}),
+				),
+			},
+			{
+				ResourceName:            fqrn,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"properties"},
+			},
+		},
+	})
+}
+
+func TestAccItemProperties_authoritative(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("props-", "artifactory_item_properties")
+	_, _, repoName := testutil.MkNames("tf-local-", "artifactory_local_generic_repository")
+
+	config := utilsdk.ExecuteTemplate("TestAccItemProperties_authoritative", `
+		resource "artifactory_local_generic_repository" "{{ .repo_name }}" {
+			key = "{{ .repo_name }}"
+		}
+
+		resource "artifactory_item_properties" "{{ .name }}" {
+			repository    = artifactory_local_generic_repository.{{ .repo_name }}.key
+			authoritative = true
+			properties = {
+				retention = ["30d"]
+			}
+		}
+	`, map[string]string{
+		"name":      name,
+		"repo_name": repoName,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5MuxProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "id", repoName),
+					resource.TestCheckResourceAttr(fqrn, "path", ""),
+					resource.TestCheckResourceAttr(fqrn, "properties.retention.0", "30d"),
+				),
+			},
+			{
+				PreConfig: func() {
+					err := artifact.SetItemProperties(acctest.GetTestResty(t), repoName, "", map[string][]string{"manual": {"true"}}, false)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					checkItemProperties(t, repoName, "", map[string][]string{"retention": {"30d"}}),
+				),
+			},
+		},
+	})
+}
+
+func checkItemProperties(t *testing.T, repoKey, itemPath string, expected map[string][]string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		properties, _, err := artifact.GetItemProperties(acctest.GetTestResty(t), repoKey, itemPath)
+		if err != nil {
+			return err
+		}
+		if !assert.ObjectsAreEqual(expected, properties) {
+			return fmt.Errorf("expected properties %v of %s in repository %s, got %v", expected, itemPath, repoKey, properties)
+		}
+		return nil
+	}
+}
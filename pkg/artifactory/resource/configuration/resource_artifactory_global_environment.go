@@ -0,0 +1,134 @@
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+)
+
+const (
+	GlobalEnvironmentsEndpoint      = "access/api/v1/environments"
+	GlobalEnvironmentEndpoint       = "access/api/v1/environments/{name}"
+	GlobalEnvironmentRenameEndpoint = "access/api/v1/environments/{name}/rename"
+	ProjectEnvironmentsEndpoint     = "access/api/v1/projects/{projectKey}/environments"
+)
+
+type Environment struct {
+	Name string `json:"name"`
+}
+
+// GetEnvironments returns the names of the global environments, and of the environments of the project if projectKey
+// isn't empty.
+func GetEnvironments(client *resty.Client, projectKey string) ([]string, error) {
+	var environments []Environment
+	_, err := client.R().SetResult(&environments).Get(GlobalEnvironmentsEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read global environments: %s", err)
+	}
+
+	if projectKey != "" {
+		var projectEnvironments []Environment
+		_, err := client.R().
+			SetResult(&projectEnvironments).
+			SetPathParam("projectKey", projectKey).
+			Get(ProjectEnvironmentsEndpoint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read environments of project %s: %s", projectKey, err)
+		}
+		environments = append(environments, projectEnvironments...)
+	}
+
+	var names []string
+	for _, environment := range environments {
+		names = append(names, environment.Name)
+	}
+	return names, nil
+}
+
+func ResourceArtifactoryGlobalEnvironment() *schema.Resource {
+	var createEnvironment = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		name := d.Get("name").(string)
+		_, err := m.(utilsdk.ProvderMetadata).Client.R().
+			SetBody(Environment{Name: name}).
+			Post(GlobalEnvironmentsEndpoint)
+		if err != nil {
+			return diag.Errorf("failed to create global environment %s: %s", name, err)
+		}
+
+		d.SetId(name)
+		return nil
+	}
+
+	var readEnvironment = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		var environments []Environment
+		_, err := m.(utilsdk.ProvderMetadata).Client.R().
+			SetResult(&environments).
+			Get(GlobalEnvironmentsEndpoint)
+		if err != nil {
+			return diag.Errorf("failed to read global environments: %s", err)
+		}
+
+		for _, environment := range environments {
+			if environment.Name == d.Id() {
+				return diag.FromErr(d.Set("name", environment.Name))
+			}
+		}
+
+		d.SetId("")
+		return nil
+	}
+
+	var updateEnvironment = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		name := d.Get("name").(string)
+		_, err := m.(utilsdk.ProvderMetadata).Client.R().
+			SetBody(map[string]string{"new_name": name}).
+			SetPathParam("name", d.Id()).
+			Post(GlobalEnvironmentRenameEndpoint)
+		if err != nil {
+			return diag.Errorf("failed to rename global environment %s to %s: %s", d.Id(), name, err)
+		}
+
+		d.SetId(name)
+		return readEnvironment(ctx, d, m)
+	}
+
+	var deleteEnvironment = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		resp, err := m.(utilsdk.ProvderMetadata).Client.R().
+			SetPathParam("name", d.Id()).
+			Delete(GlobalEnvironmentEndpoint)
+		if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+			return diag.Errorf("failed to delete global environment %s: %s", d.Id(), err)
+		}
+
+		d.SetId("")
+		return nil
+	}
+
+	return &schema.Resource{
+		CreateContext: createEnvironment,
+		ReadContext:   readEnvironment,
+		UpdateContext: updateEnvironment,
+		DeleteContext: deleteEnvironment,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				Description: "Name of the environment, e.g. `QA`. Renaming the environment renames it in all the repositories " +
+					"and projects it's assigned to.",
+			},
+		},
+		Description: "Provides an Artifactory global environment, available to all the projects. Requires Artifactory 7.53.1 or later.",
+	}
+}
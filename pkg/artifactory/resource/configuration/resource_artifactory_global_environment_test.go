@@ -0,0 +1,104 @@
+package configuration_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/configuration"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+)
+
+func checkGlobalEnvironment(id string, request *resty.Request) (*resty.Response, error) {
+	var environments []configuration.Environment
+	resp, err := request.SetResult(&environments).Get(configuration.GlobalEnvironmentsEndpoint)
+	if err != nil {
+		return resp, err
+	}
+	for _, environment := range environments {
+		if environment.Name == id {
+			return resp, nil
+		}
+	}
+	return resp, fmt.Errorf("no global environment %s", id)
+}
+
+func TestAccGlobalEnvironment(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("env-", "artifactory_global_environment")
+	environment := strings.ToUpper(name)
+	_, repoFqrn, repoName := testutil.MkNames("tf-local-", "artifactory_local_generic_repository")
+
+	const template = `
+		resource "artifactory_global_environment" "{{ .name }}" {
+			name = "{{ .environment }}"
+		}
+
+		resource "artifactory_local_generic_repository" "{{ .repo_name }}" {
+			key                  = "{{ .repo_name }}"
+			project_environments = [artifactory_global_environment.{{ .name }}.name]
+		}
+	`
+	testData := map[string]string{
+		"name":        name,
+		"environment": environment,
+		"repo_name":   repoName,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, checkGlobalEnvironment),
+		Steps: []resource.TestStep{
+			{
+				Config: utilsdk.ExecuteTemplate("TestAccGlobalEnvironment", template, testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", environment),
+					resource.TestCheckResourceAttr(repoFqrn, "project_environments.#", "1"),
+					resource.TestCheckTypeSetElemAttr(repoFqrn, "project_environments.*", environment),
+				),
+			},
+			{
+				Config: utilsdk.ExecuteTemplate("TestAccGlobalEnvironment", template, utilsdk.MergeMaps(testData, map[string]string{
+					"environment": environment + "-RENAMED",
+				})),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", environment+"-RENAMED"),
+					resource.TestCheckTypeSetElemAttr(repoFqrn, "project_environments.*", environment+"-RENAMED"),
+				),
+			},
+			{
+				ResourceName:      fqrn,
+				ImportState:       true,
+				ImportStateId:     environment + "-RENAMED",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGlobalEnvironment_unknownProjectEnvironment(t *testing.T) {
+	_, _, repoName := testutil.MkNames("tf-local-", "artifactory_local_generic_repository")
+
+	config := utilsdk.ExecuteTemplate("TestAccGlobalEnvironment_unknownProjectEnvironment", `
+		resource "artifactory_local_generic_repository" "{{ .repo_name }}" {
+			key                  = "{{ .repo_name }}"
+			project_environments = ["NOT-AN-ENVIRONMENT"]
+		}
+	`, map[string]string{"repo_name": repoName})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(".*project_environment NOT-AN-ENVIRONMENT doesn't exist globally or in project default.*"),
+			},
+		},
+	})
+}
@@ -3,12 +3,17 @@ package configuration
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/repolayout"
 	"github.com/jfrog/terraform-provider-shared/packer"
 	"gopkg.in/yaml.v3"
 )
@@ -42,7 +47,7 @@ func ResourceArtifactoryRepositoryLayout() *schema.Resource {
 		"artifact_path_pattern": {
 			Type:             schema.TypeString,
 			Required:         true,
-			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			ValidateDiagFunc: repolayout.ValidatePathPattern,
 			Description:      "Please refer to: [Path Patterns](https://www.jfrog.com/confluence/display/JFROG/Repository+Layouts#RepositoryLayouts-ModulesandPathPatternsusedbyRepositoryLayouts) in the Artifactory Wiki documentation.",
 		},
 		"distinctive_descriptor_path_pattern": {
@@ -54,21 +59,27 @@ func ResourceArtifactoryRepositoryLayout() *schema.Resource {
 		"descriptor_path_pattern": {
 			Type:             schema.TypeString,
 			Optional:         true,
-			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			ValidateDiagFunc: repolayout.ValidatePathPattern,
 			Description:      "Please refer to: [Descriptor Path Patterns](https://www.jfrog.com/confluence/display/JFROG/Repository+Layouts#RepositoryLayouts-DescriptorPathPatterns) in the Artifactory Wiki documentation.",
 		},
 		"folder_integration_revision_regexp": {
 			Type:             schema.TypeString,
 			Required:         true,
-			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			ValidateDiagFunc: repolayout.ValidateRevisionRegexp,
 			Description:      "A regular expression matching the integration revision string appearing in a folder name as part of the artifact's path. For example, 'SNAPSHOT', in Maven. Note! Take care not to introduce any regexp capturing groups within this expression. If not applicable use '.*'",
 		},
 		"file_integration_revision_regexp": {
 			Type:             schema.TypeString,
 			Required:         true,
-			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			ValidateDiagFunc: repolayout.ValidateRevisionRegexp,
 			Description:      "A regular expression matching the integration revision string appearing in a file name as part of the artifact's path. For example, 'SNAPSHOT|(?:(?:[0-9]{8}.[0-9]{6})-(?:[0-9]+))', in Maven. Note! Take care not to introduce any regexp capturing groups within this expression. If not applicable use '.*'",
 		},
+		"test_paths": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Sample artifact paths the layout is tested against during plan, e.g. `org/acme/foo/1.0/foo-1.0.jar`. Every path must match the artifact path pattern, or the descriptor path pattern when 'distinctive_descriptor_path_pattern' is set. The test is skipped if an integration revision regexp can't be compiled in Go. Not sent to Artifactory.",
+		},
 	}
 
 	var unpackLayout = func(s *schema.ResourceData) Layout {
@@ -162,6 +173,65 @@ repoLayouts:
 		return nil
 	}
 
+	var testPathsDiff = func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+		testPaths := diff.Get("test_paths").(*schema.Set).List()
+		if len(testPaths) == 0 {
+			return nil
+		}
+		for _, key := range []string{"test_paths", "artifact_path_pattern", "descriptor_path_pattern", "distinctive_descriptor_path_pattern", "folder_integration_revision_regexp", "file_integration_revision_regexp"} {
+			if !diff.NewValueKnown(key) {
+				return nil
+			}
+		}
+
+		folderItegRevRegexp := diff.Get("folder_integration_revision_regexp").(string)
+		fileItegRevRegexp := diff.Get("file_integration_revision_regexp").(string)
+		// the regexps are Java regular expressions, the ones Go can't compile are only reported as a warning by the
+		// validation, so the self-test is skipped rather than failing
+		for _, expr := range []string{folderItegRevRegexp, fileItegRevRegexp} {
+			if _, ok := repolayout.GoRevisionRegexp(expr); !ok {
+				tflog.Warn(ctx, "Skipping the test_paths self-test, an integration revision regexp can't be compiled in Go", map[string]interface{}{
+					"regexp": expr,
+				})
+				return nil
+			}
+		}
+
+		patterns := []string{diff.Get("artifact_path_pattern").(string)}
+		if diff.Get("distinctive_descriptor_path_pattern").(bool) {
+			patterns = append(patterns, diff.Get("descriptor_path_pattern").(string))
+		}
+
+		var compiled []*repolayout.Pattern
+		for _, pathPattern := range patterns {
+			pattern, err := repolayout.Compile(pathPattern, folderItegRevRegexp, fileItegRevRegexp)
+			if err != nil {
+				return err
+			}
+			compiled = append(compiled, pattern)
+		}
+
+		var failed []string
+		for _, testPath := range testPaths {
+			matched := false
+			for _, pattern := range compiled {
+				if _, ok := pattern.Parse(testPath.(string)); ok {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				failed = append(failed, testPath.(string))
+			}
+		}
+		if len(failed) > 0 {
+			sort.Strings(failed)
+			return fmt.Errorf("test_paths not matched by the layout: %s", strings.Join(failed, ", "))
+		}
+
+		return nil
+	}
+
 	return &schema.Resource{
 		UpdateContext: resourceLayoutUpdate,
 		CreateContext: resourceLayoutUpdate,
@@ -175,8 +245,11 @@ repoLayouts:
 			},
 		},
 
-		Schema:        layoutSchema,
-		CustomizeDiff: distinctiveDescriptorPathPatternDiff,
-		Description:   "Provides an Artifactory repository layout resource. See [Repository Layout documentation](https://www.jfrog.com/confluence/display/JFROG/Repository+Layouts) for more details.",
+		Schema:      layoutSchema,
+		Description: "Provides an Artifactory repository layout resource. See [Repository Layout documentation](https://www.jfrog.com/confluence/display/JFROG/Repository+Layouts) for more details.",
+		CustomizeDiff: customdiff.All(
+			distinctiveDescriptorPathPatternDiff,
+			testPathsDiff,
+		),
 	}
 }
@@ -159,3 +159,118 @@ func TestAccLayout_validate_distinctive_descriptor_path_pattern(t *testing.T) {
 		},
 	})
 }
+
+func TestAccLayout_invalid_path_pattern(t *testing.T) {
+	testCases := []struct {
+		name                string
+		artifactPathPattern string
+		errorRegex          string
+	}{
+		{"missing module", "[orgPath]/[baseRev]/[baseRev].[ext]", `.*missing mandatory tokens: \[module\].*`},
+		{"unbalanced group", "[orgPath]/[module]/[baseRev](-[folderItegRev]/[module]-[baseRev].[ext]", `.*unbalanced '\('.*`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, name := testutil.MkNames("test", "artifactory_repository_layout")
+
+			layoutConfig := utilsdk.ExecuteTemplate("layout", `
+				resource "artifactory_repository_layout" "{{ .name }}" {
+					name                               = "{{ .name }}"
+					artifact_path_pattern              = "{{ .artifactPathPattern }}"
+					folder_integration_revision_regexp = "SNAPSHOT"
+					file_integration_revision_regexp   = "SNAPSHOT"
+				}
+			`, map[string]interface{}{
+				"name":                name,
+				"artifactPathPattern": tc.artifactPathPattern,
+			})
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:          func() { acctest.PreCheck(t) },
+				ProviderFactories: acctest.ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      layoutConfig,
+						ExpectError: regexp.MustCompile(tc.errorRegex),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccLayout_test_paths(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test", "artifactory_repository_layout")
+
+	const template = `
+		resource "artifactory_repository_layout" "{{ .name }}" {
+			name                               = "{{ .name }}"
+			artifact_path_pattern              = "[orgPath]/[module]/[baseRev](-[folderItegRev])/[module]-[baseRev](-[fileItegRev])(-[classifier]).[ext]"
+			folder_integration_revision_regexp = "SNAPSHOT"
+			file_integration_revision_regexp   = "SNAPSHOT|(?:(?:[0-9]{8}.[0-9]{6})-(?:[0-9]+))"
+			test_paths                         = ["org/acme/foo/1.0/foo-1.0.jar", "{{ .testPath }}"]
+		}
+	`
+	validConfig := utilsdk.ExecuteTemplate("layout", template, map[string]interface{}{
+		"name":     name,
+		"testPath": "org/acme/foo/1.0-SNAPSHOT/foo-1.0-20230101.123456-1-sources.jar",
+	})
+	invalidConfig := utilsdk.ExecuteTemplate("layout", template, map[string]interface{}{
+		"name":     name,
+		"testPath": "org/acme/foo/1.0/bar-1.0.jar",
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccLayoutDestroy(name),
+
+		Steps: []resource.TestStep{
+			{
+				Config:      invalidConfig,
+				ExpectError: regexp.MustCompile(`.*test_paths not matched by the layout: org/acme/foo/1\.0/bar-1\.0\.jar.*`),
+			},
+			{
+				Config: validConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", name),
+					resource.TestCheckResourceAttr(fqrn, "test_paths.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLayout_test_paths_javaRevisionRegexp(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("test", "artifactory_repository_layout")
+
+	config := utilsdk.ExecuteTemplate("layout", `
+		resource "artifactory_repository_layout" "{{ .name }}" {
+			name                               = "{{ .name }}"
+			artifact_path_pattern              = "[orgPath]/[module]/[baseRev](-[folderItegRev])/[module]-[baseRev](-[fileItegRev])(-[classifier]).[ext]"
+			folder_integration_revision_regexp = "SNAPSHOT(?!-RC)"
+			file_integration_revision_regexp   = "SNAPSHOT"
+			test_paths                         = ["org/acme/foo/1.0/bar-1.0.jar"]
+		}
+	`, map[string]interface{}{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccLayoutDestroy(name),
+
+		Steps: []resource.TestStep{
+			{
+				// the self-test is skipped, the lookahead can't be compiled in Go
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "folder_integration_revision_regexp", "SNAPSHOT(?!-RC)"),
+					resource.TestCheckResourceAttr(fqrn, "test_paths.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,14 @@
+package replication
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var credentialsRotationSchema = map[string]*schema.Schema{
+	"credentials_rotation_trigger": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "Change this value, e.g. to the date of the rotation, to send the replication credentials to Artifactory again " +
+			"without changing them.",
+	},
+}
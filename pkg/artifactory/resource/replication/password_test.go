@@ -0,0 +1,89 @@
+package replication_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/replication"
+	"github.com/jfrog/terraform-provider-shared/client"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalSingleReplication_credentialsRotationTrigger(t *testing.T) {
+	var sentPasswords []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/artifactory/api/repositories/local-repo":
+			w.Write([]byte(`{"rclass":"local"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/artifactory/api/replications/local-repo":
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+			sentPasswords = append(sentPasswords, body["password"].(string))
+		case r.Method == http.MethodGet && r.URL.Path == "/artifactory/api/replications/local-repo":
+			w.Write([]byte(`{"url":"https://artifactory-2.example.com/artifactory/local-repo","username":"admin","repoKey":"local-repo"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	restyClient, err := client.Build(server.URL, "terraform-provider-artifactory/test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	restyClient, err = client.AddAuth(restyClient, "", "test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta := utilsdk.ProvderMetadata{Client: restyClient}
+
+	r := replication.ResourceArtifactoryLocalRepositorySingleReplication()
+	config := map[string]interface{}{
+		"repo_key":                     "local-repo",
+		"url":                          "https://artifactory-2.example.com/artifactory/local-repo",
+		"username":                     "admin",
+		"password":                     "Passw0rd!",
+		"credentials_rotation_trigger": "2026-01-01",
+	}
+	created := schema.TestResourceDataRaw(t, r.Schema, config)
+	created.SetId("local-repo")
+	state := created.State()
+
+	t.Run("no update when unchanged", func(t *testing.T) {
+		diff, err := schema.InternalMap(r.Schema).Diff(context.Background(), state, sdkterraform.NewResourceConfigRaw(config), nil, nil, false)
+		assert.NoError(t, err)
+		assert.True(t, diff == nil || diff.Empty())
+	})
+
+	t.Run("password sent again when the trigger is changed", func(t *testing.T) {
+		newConfig := map[string]interface{}{}
+		for k, v := range config {
+			newConfig[k] = v
+		}
+		newConfig["credentials_rotation_trigger"] = "2026-10-01"
+
+		diff, err := schema.InternalMap(r.Schema).Diff(context.Background(), state, sdkterraform.NewResourceConfigRaw(newConfig), nil, nil, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.False(t, diff.RequiresNew())
+		assert.Contains(t, diff.Attributes, "credentials_rotation_trigger")
+
+		d, err := schema.InternalMap(r.Schema).Data(state, diff)
+		if err != nil {
+			t.Fatal(err)
+		}
+		diags := r.UpdateContext(context.Background(), d, meta)
+		assert.False(t, diags.HasError(), "%v", diags)
+		assert.Equal(t, []string{"Passw0rd!"}, sentPasswords)
+	})
+}
@@ -376,6 +376,6 @@ func ResourceArtifactoryLocalRepositoryMultiReplication() *schema.Resource {
 		},
 
 		Description: "Add or replace multiple replication configurations for given repository key. Supported by local repositories. Artifactory Enterprise license is required.",
-		Schema:      localMultiReplicationSchema,
+		Schema:      utilsdk.MergeMaps(localMultiReplicationSchema, credentialsRotationSchema),
 	}
 }
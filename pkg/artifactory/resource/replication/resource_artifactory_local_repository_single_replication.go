@@ -275,6 +275,6 @@ func ResourceArtifactoryLocalRepositorySingleReplication() *schema.Resource {
 		},
 
 		Description: "Add or replace a single replication configuration for given repository key. Supported by local repositories. Artifactory Pro license is required.",
-		Schema:      localSingleReplicationSchema,
+		Schema:      utilsdk.MergeMaps(localSingleReplicationSchema, credentialsRotationSchema),
 	}
 }
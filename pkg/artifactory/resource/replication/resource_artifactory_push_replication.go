@@ -333,7 +333,7 @@ func ResourceArtifactoryPushReplication() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema:             pushRepMultipleSchema,
+		Schema:             utilsdk.MergeMaps(pushRepMultipleSchema, credentialsRotationSchema),
 		Description:        "Add or replace multiple replication configurations for given repository key. Supported by local repositories. Artifactory Enterprise license is required.",
 		DeprecationMessage: "This resource is replaced by `artifactory_local_repository_multi_replication` for clarity. All the attributes are identical, please consider the migration.",
 	}
@@ -4,10 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"net/url"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository"
@@ -15,6 +14,8 @@ import (
 	"github.com/jfrog/terraform-provider-shared/packer"
 	"github.com/jfrog/terraform-provider-shared/unpacker"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 )
 
 const rclass = "federated"
@@ -83,7 +84,7 @@ var MemberSchemaGenerator = func(isRequired bool) map[string]*schema.Schema {
 	}
 }
 
-var memberSchema = MemberSchemaGenerator(true)
+var memberSchema = utilsdk.MergeMaps(MemberSchemaGenerator(true), memberProvisioningSchema)
 
 func unpackMembers(data *schema.ResourceData) []Member {
 	d := &utilsdk.ResourceData{ResourceData: data}
@@ -130,32 +131,48 @@ func PackMembers(members []Member, d *schema.ResourceData) error {
 	return nil
 }
 func deleteRepo(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// The member repositories created by the resource are deleted with the credentials of their member
+	credentials := getMemberCredentials(d.Get("member_credentials"))
+	createdMembers := maps.Keys(getCreatedMembers(d))
+	slices.Sort(createdMembers)
+	if diags := deleteCreatedMembers(m, createdMembers, credentials); diags.HasError() {
+		return diags
+	}
+
 	// For federated repositories we delete all the federated members (except the initial repo member), if the flag `cleanup_on_delete` is set to `true`
 	s := &utilsdk.ResourceData{ResourceData: d}
 	initialRepoName := s.GetString("key", false)
 	if v, ok := d.GetOk("member"); ok && s.GetBool("cleanup_on_delete", false) {
-		// Save base URL from the Client to be able to revert it back after the change below
-		baseURL := m.(utilsdk.ProvderMetadata).Client.BaseURL
 		federatedMembers := v.(*schema.Set).List()
 		for _, federatedMember := range federatedMembers {
 			id := federatedMember.(map[string]interface{})
 			memberUrl := id["url"].(string) // example "https://artifactory-instance.com/artifactory/federated-generic-repository-example"
-			parsedMemberUrl, _ := url.Parse(memberUrl)
-			memberHost := memberUrl[:strings.Index(memberUrl, parsedMemberUrl.Path)]
-			memberRepoName := strings.ReplaceAll(memberUrl, memberUrl[:strings.LastIndex(memberUrl, "/")+1], "")
-			if initialRepoName != memberRepoName || !strings.HasPrefix(memberUrl, baseURL) {
-				resp, err := m.(utilsdk.ProvderMetadata).Client.SetBaseURL(memberHost).R().
-					AddRetryCondition(client.RetryOnMergeError).
-					SetPathParam("key", memberRepoName).
-					Delete(RepositoriesEndpoint)
-				if err != nil && (resp != nil && (resp.StatusCode() == http.StatusBadRequest ||
-					resp.StatusCode() == http.StatusNotFound || resp.StatusCode() == http.StatusUnauthorized)) {
-					m.(utilsdk.ProvderMetadata).Client.SetBaseURL(baseURL)
-					return diag.FromErr(err)
-				}
+			if _, ok := credentials[memberUrl]; ok {
+				// member repositories with credentials are only deleted if created by the resource
+				continue
+			}
+			member, err := parseMemberUrl(memberUrl)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if isOwnMember(member, initialRepoName, m) {
+				continue
+			}
+			// The member is deleted with a client of its own, as the client of the provider is shared with the
+			// resources being applied in parallel
+			c, err := memberClient(m, member.Host, "")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			resp, err := c.R().
+				AddRetryCondition(client.RetryOnMergeError).
+				SetPathParam("key", member.Key).
+				Delete(RepositoriesEndpoint)
+			// a member already deleted is ignored, any other failure leaves the member behind
+			if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+				return diag.Errorf("failed to delete member repository %s: %s", memberUrl, err)
 			}
 		}
-		m.(utilsdk.ProvderMetadata).Client.SetBaseURL(baseURL)
 	}
 
 	resp, err := m.(utilsdk.ProvderMetadata).Client.R().
@@ -173,9 +190,9 @@ func deleteRepo(_ context.Context, d *schema.ResourceData, m interface{}) diag.D
 func mkResourceSchema(skeema map[string]*schema.Schema, packer packer.PackFunc, unpack unpacker.UnpackFunc, constructor repository.Constructor) *schema.Resource {
 	var reader = repository.MkRepoRead(packer, constructor)
 	return &schema.Resource{
-		CreateContext: repository.MkRepoCreate(unpack, reader),
-		ReadContext:   reader,
-		UpdateContext: repository.MkRepoUpdate(unpack, reader),
+		CreateContext: mkProvisionMembers(unpack, repository.MkVerifyReferences(constructor, repository.MkRepoCreate(unpack, reader))),
+		ReadContext:   mkReadMemberStatus(reader),
+		UpdateContext: mkProvisionMembers(unpack, repository.MkVerifyReferences(constructor, repository.MkRepoUpdate(unpack, reader))),
 		DeleteContext: deleteRepo,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -183,6 +200,9 @@ func mkResourceSchema(skeema map[string]*schema.Schema, packer packer.PackFunc,
 
 		Schema:        skeema,
 		SchemaVersion: 2,
-		CustomizeDiff: repository.ProjectEnvironmentsDiff,
+		CustomizeDiff: customdiff.All(
+			repository.ProjectEnvironmentsDiff,
+			memberCredentialsDiff,
+		),
 	}
 }
@@ -0,0 +1,177 @@
+package federated_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository/federated"
+	"github.com/jfrog/terraform-provider-shared/client"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+// artifactoryServer records the repositories deleted on it.
+type artifactoryServer struct {
+	*httptest.Server
+	mu      sync.Mutex
+	deleted []string
+	status  int
+}
+
+func newArtifactoryServer(t *testing.T, status int) *artifactoryServer {
+	s := &artifactoryServer{status: status}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/artifactory/api/repositories/") {
+			s.mu.Lock()
+			s.deleted = append(s.deleted, strings.TrimPrefix(r.URL.Path, "/artifactory/api/repositories/"))
+			s.mu.Unlock()
+		}
+		w.WriteHeader(s.status)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *artifactoryServer) deletedRepos() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.deleted...)
+}
+
+func mkProviderMetadata(t *testing.T, url string) utilsdk.ProvderMetadata {
+	restyClient, err := client.Build(url, "terraform-provider-artifactory/test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	restyClient, err = client.AddAuth(restyClient, "", "test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return utilsdk.ProvderMetadata{Client: restyClient}
+}
+
+func mkFederatedResourceData(t *testing.T, r *schema.Resource, key string, memberUrls ...string) *schema.ResourceData {
+	var members []interface{}
+	for _, memberUrl := range memberUrls {
+		members = append(members, map[string]interface{}{
+			"url":     memberUrl,
+			"enabled": true,
+		})
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"key":               key,
+		"cleanup_on_delete": true,
+		"member":            members,
+	})
+	d.SetId(key)
+	return d
+}
+
+func TestDeleteRepo_concurrentMemberCleanup(t *testing.T) {
+	primary := newArtifactoryServer(t, http.StatusOK)
+	meta := mkProviderMetadata(t, primary.URL)
+	r := federated.ResourceArtifactoryFederatedGenericRepository("generic")
+
+	const count = 8
+	members := make([]*artifactoryServer, count)
+	data := make([]*schema.ResourceData, count)
+	for i := range members {
+		key := fmt.Sprintf("federated-generic-%d", i)
+		members[i] = newArtifactoryServer(t, http.StatusOK)
+		data[i] = mkFederatedResourceData(t, r, key,
+			fmt.Sprintf("%s/artifactory/%s", primary.URL, key),
+			fmt.Sprintf("%s/artifactory/%s", members[i].URL, key),
+		)
+	}
+
+	var wg sync.WaitGroup
+	for i := range data {
+		wg.Add(1)
+		go func(d *schema.ResourceData) {
+			defer wg.Done()
+			diags := r.DeleteContext(context.Background(), d, meta)
+			assert.False(t, diags.HasError(), "unexpected error: %v", diags)
+		}(data[i])
+	}
+	wg.Wait()
+
+	assert.Equal(t, primary.URL, meta.Client.BaseURL, "the base URL of the provider client must not change")
+	assert.Len(t, primary.deletedRepos(), count)
+	for i, member := range members {
+		key := fmt.Sprintf("federated-generic-%d", i)
+		assert.Contains(t, primary.deletedRepos(), key)
+		assert.Equal(t, []string{key}, member.deletedRepos(), "member %d must only receive the delete of its repository", i)
+	}
+}
+
+func TestDeleteRepo_memberCleanupError(t *testing.T) {
+	primary := newArtifactoryServer(t, http.StatusOK)
+	member := newArtifactoryServer(t, http.StatusUnauthorized)
+	meta := mkProviderMetadata(t, primary.URL)
+	r := federated.ResourceArtifactoryFederatedGenericRepository("generic")
+
+	d := mkFederatedResourceData(t, r, "federated-generic",
+		fmt.Sprintf("%s/artifactory/federated-generic", primary.URL),
+		fmt.Sprintf("%s/artifactory/federated-generic", member.URL),
+	)
+
+	diags := r.DeleteContext(context.Background(), d, meta)
+
+	assert.True(t, diags.HasError(), "expected the member cleanup to fail")
+	assert.Equal(t, primary.URL, meta.Client.BaseURL, "the base URL of the provider client must not change")
+	assert.Equal(t, []string{"federated-generic"}, member.deletedRepos())
+	assert.Empty(t, primary.deletedRepos())
+}
+
+func TestDeleteRepo_memberAlreadyDeleted(t *testing.T) {
+	primary := newArtifactoryServer(t, http.StatusOK)
+	member := newArtifactoryServer(t, http.StatusNotFound)
+	meta := mkProviderMetadata(t, primary.URL)
+	r := federated.ResourceArtifactoryFederatedGenericRepository("generic")
+
+	d := mkFederatedResourceData(t, r, "federated-generic",
+		fmt.Sprintf("%s/artifactory/federated-generic", primary.URL),
+		fmt.Sprintf("%s/artifactory/federated-generic", member.URL),
+	)
+
+	diags := r.DeleteContext(context.Background(), d, meta)
+
+	assert.False(t, diags.HasError(), "unexpected error: %v", diags)
+	assert.Equal(t, []string{"federated-generic"}, member.deletedRepos())
+	assert.Equal(t, []string{"federated-generic"}, primary.deletedRepos())
+}
+
+func TestDeleteRepo_createdMemberCleanupError(t *testing.T) {
+	primary := newArtifactoryServer(t, http.StatusOK)
+	member := newArtifactoryServer(t, http.StatusBadRequest)
+	meta := mkProviderMetadata(t, primary.URL)
+	r := federated.ResourceArtifactoryFederatedGenericRepository("generic")
+
+	memberUrl := fmt.Sprintf("%s/artifactory/federated-generic", member.URL)
+	created := mkFederatedResourceData(t, r, "federated-generic",
+		fmt.Sprintf("%s/artifactory/federated-generic", primary.URL),
+		memberUrl,
+	)
+	assert.NoError(t, created.Set("member_credentials", map[string]interface{}{memberUrl: "member-token"}))
+	assert.NoError(t, created.Set("member_status", []interface{}{
+		map[string]interface{}{"url": memberUrl, "created": true, "status": "ok", "error": ""},
+	}))
+	d, err := schema.InternalMap(r.Schema).Data(created.State(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diags := r.DeleteContext(context.Background(), d, meta)
+
+	// only a member already deleted is ignored, like the members without credentials
+	assert.True(t, diags.HasError(), "expected the deletion of the created member to fail")
+	assert.Equal(t, []string{"federated-generic"}, member.deletedRepos())
+	assert.Empty(t, primary.deletedRepos())
+}
@@ -0,0 +1,401 @@
+package federated
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jfrog/terraform-provider-shared/client"
+	"github.com/jfrog/terraform-provider-shared/unpacker"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	MemberStatusOk       = "ok"
+	MemberStatusMissing  = "missing"
+	MemberStatusMismatch = "mismatch"
+	MemberStatusError    = "error"
+)
+
+var memberProvisioningSchema = map[string]*schema.Schema{
+	"member_credentials": {
+		Type:      schema.TypeMap,
+		Optional:  true,
+		Sensitive: true,
+		Elem:      &schema.Schema{Type: schema.TypeString},
+		Description: "Access tokens of the Artifactory instances of the members, by member `url`. The repository of a member " +
+			"with credentials is created on its instance if missing, or verified and configured like this repository otherwise, " +
+			"with its own access token instead of the one of the provider. Member repositories created this way are deleted " +
+			"with this repository, or when the member is removed. Other member repositories are never deleted by this mechanism.",
+	},
+	"member_status": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"url": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "URL of the member.",
+				},
+				"created": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "`true` if the member repository was created by this resource, which deletes it.",
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+					Description: "`ok` if the member repository is a federated repository of the same package type, `missing` if " +
+						"it doesn't exist, `mismatch` if it has another class or package type, `error` if it couldn't be read.",
+				},
+				"error": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Details of a status other than `ok`.",
+				},
+			},
+		},
+		Description: "Status of the member repositories with `member_credentials`, by member URL.",
+	},
+}
+
+// memberRepo is a member repository, parsed from its URL, e.g. `https://artifactory-2.example.com/artifactory/repo-key`.
+type memberRepo struct {
+	Url  string
+	Host string
+	Key  string
+}
+
+func parseMemberUrl(memberUrl string) (memberRepo, error) {
+	u, err := url.Parse(memberUrl)
+	if err != nil {
+		return memberRepo{}, err
+	}
+	if u.Scheme == "" || u.Host == "" || strings.Trim(u.Path, "/") == "" {
+		return memberRepo{}, fmt.Errorf("member url %s must end with the repository key", memberUrl)
+	}
+	return memberRepo{
+		Url:  memberUrl,
+		Host: fmt.Sprintf("%s://%s", u.Scheme, u.Host),
+		Key:  path.Base(strings.TrimSuffix(u.Path, "/")),
+	}, nil
+}
+
+// memberClient returns a client for the Artifactory instance of a member, authenticated with accessToken, or with the
+// credentials of the provider if empty. The client of the provider is never modified.
+func memberClient(m interface{}, host, accessToken string) (*resty.Client, error) {
+	shared := m.(utilsdk.ProvderMetadata).Client
+	productId := strings.TrimPrefix(shared.Header.Get("User-Agent"), "jfrog/")
+	c, err := client.Build(host, productId)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey := ""
+	if accessToken == "" {
+		accessToken = shared.Token
+		apiKey = shared.Header.Get("X-JFrog-Art-Api")
+	}
+	return client.AddAuth(c, apiKey, accessToken)
+}
+
+type memberStatus struct {
+	Url    string
+	Status string
+	Error  string
+}
+
+func getMemberCredentials(credentials interface{}) map[string]string {
+	tokens := map[string]string{}
+	for memberUrl, token := range credentials.(map[string]interface{}) {
+		tokens[memberUrl] = token.(string)
+	}
+	return tokens
+}
+
+// getCreatedMembers returns the URLs of the member repositories created by the resource, as recorded in the state.
+func getCreatedMembers(d *schema.ResourceData) map[string]bool {
+	// the previous status, as `member_status` is unknown while it's being updated
+	statuses, _ := d.GetChange("member_status")
+	created := map[string]bool{}
+	for _, s := range statuses.([]interface{}) {
+		if status, ok := s.(map[string]interface{}); ok && status["created"].(bool) {
+			created[status["url"].(string)] = true
+		}
+	}
+	return created
+}
+
+// getMemberPayload returns the repository as sent to Artifactory, to be sent to the members with another key.
+func getMemberPayload(unpack unpacker.UnpackFunc, d *schema.ResourceData) (map[string]interface{}, error) {
+	repo, _, err := unpack(d)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(repo)
+	if err != nil {
+		return nil, err
+	}
+	payload := map[string]interface{}{}
+	return payload, json.Unmarshal(body, &payload)
+}
+
+// isOwnMember returns true if the member is the repository managed by the resource, on the instance of the provider.
+func isOwnMember(member memberRepo, key string, m interface{}) bool {
+	return member.Key == key && strings.HasPrefix(member.Url, m.(utilsdk.ProvderMetadata).Client.BaseURL)
+}
+
+func checkMember(c *resty.Client, member memberRepo, packageType string) memberStatus {
+	status := memberStatus{Url: member.Url}
+
+	repo := repositoryClass{}
+	resp, err := c.R().
+		SetResult(&repo).
+		SetPathParam("key", member.Key).
+		Get(RepositoriesEndpoint)
+	switch {
+	case err != nil && resp != nil && (resp.StatusCode() == http.StatusBadRequest || resp.StatusCode() == http.StatusNotFound):
+		status.Status = MemberStatusMissing
+		status.Error = fmt.Sprintf("repository %s doesn't exist on %s", member.Key, member.Host)
+	case err != nil:
+		status.Status = MemberStatusError
+		status.Error = err.Error()
+	case repo.Rclass != rclass || repo.PackageType != packageType:
+		status.Status = MemberStatusMismatch
+		status.Error = fmt.Sprintf("repository %s on %s is a %s %s repository, expected a %s %s repository",
+			member.Key, member.Host, repo.PackageType, repo.Rclass, packageType, rclass)
+	default:
+		status.Status = MemberStatusOk
+	}
+	return status
+}
+
+// provisionMember creates the member repository if it doesn't exist, otherwise verifies it's a federated repository of
+// the same package type and updates its configuration. It returns true if the repository was created.
+func provisionMember(m interface{}, member memberRepo, accessToken string, payload map[string]interface{}) (bool, error) {
+	c, err := memberClient(m, member.Host, accessToken)
+	if err != nil {
+		return false, err
+	}
+
+	memberPayload := maps.Clone(payload)
+	memberPayload["key"] = member.Key
+
+	packageType, _ := payload["packageType"].(string)
+	status := checkMember(c, member, packageType)
+	switch status.Status {
+	case MemberStatusMissing:
+		_, err := c.R().
+			AddRetryCondition(client.RetryOnMergeError).
+			SetBody(memberPayload).
+			SetPathParam("key", member.Key).
+			Put(RepositoriesEndpoint)
+		if err != nil {
+			return false, fmt.Errorf("failed to create member repository %s: %s", member.Url, err)
+		}
+		return true, nil
+	case MemberStatusOk:
+		_, err := c.R().
+			AddRetryCondition(client.RetryOnMergeError).
+			SetBody(memberPayload).
+			SetPathParam("key", member.Key).
+			Post(RepositoriesEndpoint)
+		if err != nil {
+			return false, fmt.Errorf("failed to configure member repository %s: %s", member.Url, err)
+		}
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to verify member repository %s: %s", member.Url, status.Error)
+	}
+}
+
+func deleteMember(m interface{}, member memberRepo, accessToken string) error {
+	c, err := memberClient(m, member.Host, accessToken)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.R().
+		AddRetryCondition(client.RetryOnMergeError).
+		SetPathParam("key", member.Key).
+		Delete(RepositoriesEndpoint)
+	// a member already deleted is ignored, any other failure leaves the member behind
+	if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+		return fmt.Errorf("failed to delete member repository %s: %s", member.Url, err)
+	}
+	return nil
+}
+
+// deleteCreatedMembers deletes the member repositories in created, with the credentials of the members.
+func deleteCreatedMembers(m interface{}, created []string, credentials map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, memberUrl := range created {
+		member, err := parseMemberUrl(memberUrl)
+		if err == nil {
+			err = deleteMember(m, member, credentials[memberUrl])
+		}
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+	return diags
+}
+
+func setMemberStatus(d *schema.ResourceData, m interface{}, created map[string]bool) diag.Diagnostics {
+	credentials := getMemberCredentials(d.Get("member_credentials"))
+	packageType := d.Get("package_type").(string)
+
+	memberUrls := maps.Keys(credentials)
+	slices.Sort(memberUrls)
+
+	var statuses []interface{}
+	for _, memberUrl := range memberUrls {
+		member, err := parseMemberUrl(memberUrl)
+		if err != nil || isOwnMember(member, d.Id(), m) {
+			continue
+		}
+
+		var status memberStatus
+		c, err := memberClient(m, member.Host, credentials[memberUrl])
+		if err != nil {
+			status = memberStatus{Url: memberUrl, Status: MemberStatusError, Error: err.Error()}
+		} else {
+			status = checkMember(c, member, packageType)
+		}
+		statuses = append(statuses, map[string]interface{}{
+			"url":     status.Url,
+			"created": created[memberUrl],
+			"status":  status.Status,
+			"error":   status.Error,
+		})
+	}
+
+	setValue := utilsdk.MkLens(d)
+	errors := setValue("member_status", statuses)
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed to pack member status %q", errors)
+	}
+	return nil
+}
+
+// mkProvisionMembers wraps a create or update function to provision the member repositories with credentials before
+// the repository is created or updated, so Artifactory finds them.
+func mkProvisionMembers(unpack unpacker.UnpackFunc, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		key := d.Get("key").(string)
+		oldCredentials, newCredentials := d.GetChange("member_credentials")
+		credentials := getMemberCredentials(newCredentials)
+		created := getCreatedMembers(d)
+
+		// members created by the resource and removed from the configuration are deleted, with their last credentials
+		var removed []string
+		for memberUrl := range created {
+			if _, ok := credentials[memberUrl]; ok {
+				continue
+			}
+			delete(created, memberUrl)
+			if !slices.ContainsFunc(unpackMembers(d), func(member Member) bool { return member.Url == memberUrl }) {
+				removed = append(removed, memberUrl)
+				continue
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Member repository %s is no longer managed", memberUrl),
+				Detail:   "The credentials of the member were removed, so the member repository is kept when this repository is deleted.",
+			})
+		}
+		if errs := deleteCreatedMembers(m, removed, getMemberCredentials(oldCredentials)); errs.HasError() {
+			return append(diags, errs...)
+		}
+
+		payload, err := getMemberPayload(unpack, d)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		memberUrls := maps.Keys(credentials)
+		slices.Sort(memberUrls)
+
+		var provisioned []string
+		for _, memberUrl := range memberUrls {
+			member, err := parseMemberUrl(memberUrl)
+			if err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+			if isOwnMember(member, key, m) {
+				continue
+			}
+
+			isCreated, err := provisionMember(m, member, credentials[memberUrl], payload)
+			if err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+				if d.Id() == "" {
+					return append(diags, deleteCreatedMembers(m, provisioned, credentials)...)
+				}
+				break
+			}
+			if isCreated {
+				created[memberUrl] = true
+				provisioned = append(provisioned, memberUrl)
+			}
+		}
+
+		if !diags.HasError() {
+			diags = append(diags, f(ctx, d, m)...)
+		}
+		if d.Id() == "" {
+			// the repository wasn't created, so the member repositories created for it are deleted
+			return append(diags, deleteCreatedMembers(m, provisioned, credentials)...)
+		}
+
+		return append(diags, setMemberStatus(d, m, created)...)
+	}
+}
+
+// mkReadMemberStatus wraps a read function to refresh the status of the member repositories with credentials.
+func mkReadMemberStatus(read schema.ReadContextFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := read(ctx, d, m)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		if len(d.Get("member_credentials").(map[string]interface{})) == 0 && len(d.Get("member_status").([]interface{})) == 0 {
+			return diags
+		}
+		return append(diags, setMemberStatus(d, m, getCreatedMembers(d))...)
+	}
+}
+
+// memberCredentialsDiff verifies the credentials are for members of the repository, and marks `member_status` as
+// computed when the members change.
+func memberCredentialsDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	memberUrls := map[string]bool{}
+	for _, member := range diff.Get("member").(*schema.Set).List() {
+		memberUrls[member.(map[string]interface{})["url"].(string)] = true
+	}
+
+	for memberUrl := range diff.Get("member_credentials").(map[string]interface{}) {
+		if !memberUrls[memberUrl] {
+			return fmt.Errorf("member_credentials has credentials for %s, which is not the url of a member", memberUrl)
+		}
+		if _, err := parseMemberUrl(memberUrl); err != nil {
+			return err
+		}
+	}
+
+	if diff.Id() != "" && (diff.HasChange("member") || diff.HasChange("member_credentials")) {
+		return diff.SetNewComputed("member_status")
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package federated
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+	"golang.org/x/exp/slices"
+)
+
+// ConvertToFederatedEndpoint converts a local repository to a federated repository in place, keeping its content and
+// configuration.
+const ConvertToFederatedEndpoint = "artifactory/api/federation/migrate/{key}"
+
+// ConvertiblePackageTypes are the package types with a federated repository resource.
+var ConvertiblePackageTypes = append(
+	append([]string{"alpine", "cargo", "debian", "docker", "maven", "nuget", "rpm", "terraform"}, PackageTypesLikeGeneric...),
+	repository.GradleLikePackageTypes...,
+)
+
+type repositoryClass struct {
+	Key         string `json:"key"`
+	Rclass      string `json:"rclass"`
+	PackageType string `json:"packageType"`
+}
+
+func getRepositoryClass(key string, m interface{}) (*repositoryClass, *resty.Response, error) {
+	repo := repositoryClass{}
+	resp, err := m.(utilsdk.ProvderMetadata).Client.R().
+		SetResult(&repo).
+		SetPathParam("key", key).
+		Get(RepositoriesEndpoint)
+	return &repo, resp, err
+}
+
+func ResourceArtifactoryFederatedRepositoryConversion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFederatedRepositoryConversionCreate,
+		ReadContext:   resourceFederatedRepositoryConversionRead,
+		DeleteContext: resourceFederatedRepositoryConversionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repo_key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				Description:      "Key of the local repository to convert. A repository that is already federated is left as is.",
+			},
+			"package_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Package type of the repository, e.g. `maven` for `artifactory_federated_maven_repository`.",
+			},
+			"rclass": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Class of the repository, `federated` once converted.",
+			},
+		},
+		Description: "Converts a local repository to a federated repository in place, keeping its artifacts and configuration. " +
+			"Once converted, the repository is managed with the `artifactory_federated_<package_type>_repository` resource, imported " +
+			"with the repository key. Deleting this resource only removes it from the state, the repository stays federated.",
+	}
+}
+
+func resourceFederatedRepositoryConversionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	key := d.Get("repo_key").(string)
+
+	repo, _, err := getRepositoryClass(key, m)
+	if err != nil {
+		return diag.Errorf("failed to read repository %s: %s", key, err)
+	}
+
+	switch repo.Rclass {
+	case rclass:
+	case "local":
+		if !slices.Contains(ConvertiblePackageTypes, repo.PackageType) {
+			return diag.Errorf("%s repositories can't be federated, repository %s", repo.PackageType, key)
+		}
+		_, err := m.(utilsdk.ProvderMetadata).Client.R().
+			SetPathParam("key", key).
+			Post(ConvertToFederatedEndpoint)
+		if err != nil {
+			return diag.Errorf("failed to convert repository %s to a federated repository: %s", key, err)
+		}
+	default:
+		return diag.Errorf("repository %s is a %s repository, only local repositories can be converted to federated repositories", key, repo.Rclass)
+	}
+
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		repo, _, err := getRepositoryClass(key, m)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to read repository %s: %s", key, err))
+		}
+		if repo.Rclass != rclass {
+			return retry.RetryableError(fmt.Errorf("expected repository %s to be converted to a federated repository, but it's still %s", key, repo.Rclass))
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(key)
+	return resourceFederatedRepositoryConversionRead(ctx, d, m)
+}
+
+func resourceFederatedRepositoryConversionRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	repo, resp, err := getRepositoryClass(d.Id(), m)
+	if err != nil {
+		if resp != nil && (resp.StatusCode() == http.StatusBadRequest || resp.StatusCode() == http.StatusNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	setValue := utilsdk.MkLens(d)
+	setValue("repo_key", repo.Key)
+	setValue("package_type", repo.PackageType)
+	errors := setValue("rclass", repo.Rclass)
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed to pack federated repository conversion %q", errors)
+	}
+
+	return nil
+}
+
+// resourceFederatedRepositoryConversionDelete removes the conversion from the state, a federated repository can't be
+// converted back to a local repository.
+func resourceFederatedRepositoryConversionDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,111 @@
+package federated_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+)
+
+func TestAccFederatedRepositoryConversion(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("tf-conversion-", "artifactory_federated_repository_conversion")
+	_, localFqrn, repoName := testutil.MkNames("tf-local-generic-", "artifactory_local_generic_repository")
+	federatedFqrn := fmt.Sprintf("artifactory_federated_generic_repository.%s", repoName)
+
+	testData := map[string]string{
+		"name":      name,
+		"repo_name": repoName,
+		"memberUrl": fmt.Sprintf("%s/artifactory/%s", acctest.GetArtifactoryUrl(t), repoName),
+	}
+
+	conversionConfig := utilsdk.ExecuteTemplate("TestAccFederatedRepositoryConversion", `
+		resource "artifactory_local_generic_repository" "{{ .repo_name }}" {
+			key = "{{ .repo_name }}"
+		}
+
+		resource "artifactory_federated_repository_conversion" "{{ .name }}" {
+			repo_key = artifactory_local_generic_repository.{{ .repo_name }}.key
+		}
+	`, testData)
+
+	// the local repository resource is replaced by the federated one, the repository itself is kept
+	federatedConfig := utilsdk.ExecuteTemplate("TestAccFederatedRepositoryConversion", `
+		resource "artifactory_federated_generic_repository" "{{ .repo_name }}" {
+			key = "{{ .repo_name }}"
+
+			member {
+				url     = "{{ .memberUrl }}"
+				enabled = true
+			}
+		}
+	`, testData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(localFqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: conversionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "repo_key", repoName),
+					resource.TestCheckResourceAttr(fqrn, "package_type", "generic"),
+					resource.TestCheckResourceAttr(fqrn, "rclass", "federated"),
+				),
+			},
+			{
+				ResourceName:      fqrn,
+				ImportState:       true,
+				ImportStateId:     repoName,
+				ImportStateVerify: true,
+			},
+			{
+				Config:        federatedConfig,
+				ResourceName:  federatedFqrn,
+				ImportState:   true,
+				ImportStateId: repoName,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].Attributes["key"] != repoName {
+						return fmt.Errorf("expected repository %s to be imported, got %v", repoName, states)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccFederatedRepositoryConversion_remote(t *testing.T) {
+	_, _, name := testutil.MkNames("tf-conversion-", "artifactory_federated_repository_conversion")
+	_, _, repoName := testutil.MkNames("tf-remote-generic-", "artifactory_remote_generic_repository")
+
+	config := utilsdk.ExecuteTemplate("TestAccFederatedRepositoryConversion_remote", `
+		resource "artifactory_remote_generic_repository" "{{ .repo_name }}" {
+			key = "{{ .repo_name }}"
+			url = "https://example.com/"
+		}
+
+		resource "artifactory_federated_repository_conversion" "{{ .name }}" {
+			repo_key = artifactory_remote_generic_repository.{{ .repo_name }}.key
+		}
+	`, map[string]string{
+		"name":      name,
+		"repo_name": repoName,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(".*only local repositories can be converted to federated repositories.*"),
+			},
+		},
+	})
+}
@@ -3,6 +3,7 @@ package federated_test
 import (
 	"fmt"
 	"math/rand"
+	"net/http"
 	"os"
 	"regexp"
 	"strings"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/acctest"
 	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository/federated"
@@ -97,6 +99,94 @@ func TestAccFederatedRepoWithMembers(t *testing.T) {
 	})
 }
 
+// In addition to `ARTIFACTORY_URL_2`, set `ARTIFACTORY_ACCESS_TOKEN_2` to an access token of the second instance.
+func TestAccFederatedRepoWithMemberCredentials(t *testing.T) {
+	if skip, reason := skipFederatedRepo(); skip {
+		t.Skipf(reason)
+	}
+	accessToken2 := os.Getenv("ARTIFACTORY_ACCESS_TOKEN_2")
+	if accessToken2 == "" {
+		t.Skipf("Env var `ARTIFACTORY_ACCESS_TOKEN_2` is not set. Skipping test.")
+	}
+
+	name := fmt.Sprintf("federated-generic-%d-credentials", rand.Int())
+	resourceType := "artifactory_federated_generic_repository"
+	fqrn := fmt.Sprintf("%s.%s", resourceType, name)
+	federatedMember1Url := fmt.Sprintf("%s/artifactory/%s", acctest.GetArtifactoryUrl(t), name)
+	federatedMember2Url := fmt.Sprintf("%s/artifactory/%s", os.Getenv("ARTIFACTORY_URL_2"), name)
+
+	params := map[string]interface{}{
+		"resourceType": resourceType,
+		"name":         name,
+		"member1Url":   federatedMember1Url,
+		"member2Url":   federatedMember2Url,
+		"accessToken2": accessToken2,
+	}
+	federatedRepositoryConfig := utilsdk.ExecuteTemplate("TestAccFederatedRepoWithMemberCredentials", `
+		resource "{{ .resourceType }}" "{{ .name }}" {
+			key         = "{{ .name }}"
+			description = "Test federated repo for {{ .name }}"
+
+			member {
+				url     = "{{ .member1Url }}"
+				enabled = true
+			}
+
+			member {
+				url     = "{{ .member2Url }}"
+				enabled = true
+			}
+
+			member_credentials = {
+				"{{ .member2Url }}" = "{{ .accessToken2 }}"
+			}
+		}
+	`, params)
+
+	member2Deleted := func(_ *terraform.State) error {
+		resp, err := resty.New().
+			SetBaseURL(os.Getenv("ARTIFACTORY_URL_2")).
+			SetAuthToken(accessToken2).
+			R().
+			Head("artifactory/api/repositories/" + name)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode() != http.StatusBadRequest && resp.StatusCode() != http.StatusNotFound {
+			return fmt.Errorf("member repository %s still exists", federatedMember2Url)
+		}
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy: acctest.CompositeCheckDestroy(
+			acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+			member2Deleted,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: federatedRepositoryConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "member.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "member_status.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "member_status.0.url", federatedMember2Url),
+					resource.TestCheckResourceAttr(fqrn, "member_status.0.created", "true"),
+					resource.TestCheckResourceAttr(fqrn, "member_status.0.status", federated.MemberStatusOk),
+				),
+			},
+			{
+				ResourceName:            fqrn,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateCheck:        validator.CheckImportState(name, "key"),
+				ImportStateVerifyIgnore: []string{"cleanup_on_delete", "member_credentials", "member_status"},
+			},
+		},
+	})
+}
+
 func federatedTestCase(repoType string, t *testing.T) (*testing.T, resource.TestCase) {
 	if skip, reason := skipFederatedRepo(); skip {
 		t.Skipf(reason)
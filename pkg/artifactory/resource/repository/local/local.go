@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/antpath"
 	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 )
@@ -62,16 +63,18 @@ var BaseLocalRepoSchema = utilsdk.MergeMaps(
 	repository.BaseRepoSchema,
 	map[string]*schema.Schema{
 		"includes_pattern": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Computed:    true,
-			Description: "List of artifact patterns to include when evaluating artifact requests in the form of x/y/**/z/*. When used, only artifacts matching one of the include patterns are served. By default, all artifacts are included (**/*).",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: antpath.ValidatePatternList,
+			Description:      "List of artifact patterns to include when evaluating artifact requests in the form of x/y/**/z/*. When used, only artifacts matching one of the include patterns are served. By default, all artifacts are included (**/*).",
 		},
 		"excludes_pattern": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Computed:    true,
-			Description: "List of artifact patterns to exclude when evaluating artifact requests, in the form of x/y/**/z/*. By default no artifacts are excluded.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: antpath.ValidateExcludesPatternList,
+			Description:      "List of artifact patterns to exclude when evaluating artifact requests, in the form of x/y/**/z/*. By default no artifacts are excluded.",
 		},
 		"blacked_out": {
 			Type:        schema.TypeBool,
@@ -1040,3 +1040,79 @@ func TestAccLocalCargoRepository(t *testing.T) {
 		},
 	})
 }
+
+func TestAccLocalDebianRepository_missing_references(t *testing.T) {
+	_, _, name := testutil.MkNames("local-debian-repo", "artifactory_local_debian_repository")
+	config := utilsdk.ExecuteTemplate("TestAccLocalDebianRepository", `
+		resource "artifactory_local_debian_repository" "{{ .name }}" {
+			key                 = "{{ .name }}"
+			primary_keypair_ref = "{{ .name }}-missing-keypair"
+			property_sets       = ["{{ .name }}-missing-property-set"]
+		}
+	`, map[string]interface{}{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*primary_keypair_ref "` + name + `-missing-keypair" does not exist.*`),
+			},
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*property_sets "` + name + `-missing-property-set" does not exist.*`),
+			},
+		},
+	})
+}
+
+func TestAccLocalGenericRepository_references_created_in_same_apply(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("local-generic-repo", "artifactory_local_generic_repository")
+	_, _, propertySetName := testutil.MkNames("property-set", "artifactory_property_set")
+	config := utilsdk.ExecuteTemplate("TestAccLocalGenericRepository", `
+		resource "artifactory_property_set" "{{ .property_set_name }}" {
+			name    = "{{ .property_set_name }}"
+			visible = true
+
+			property {
+				name = "status"
+
+				predefined_value {
+					name          = "passed-QA"
+					default_value = true
+				}
+
+				closed_predefined_values = true
+				multiple_choice          = false
+			}
+		}
+
+		resource "artifactory_local_generic_repository" "{{ .name }}" {
+			key           = "{{ .name }}"
+			property_sets = ["{{ .property_set_name }}"]
+			depends_on    = [artifactory_property_set.{{ .property_set_name }}]
+		}
+	`, map[string]interface{}{
+		"name":              name,
+		"property_set_name": propertySetName,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				// the literal name of the property set is only checked on apply, once the property set is created
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "property_sets.#", "1"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "property_sets.*", propertySetName),
+				),
+			},
+		},
+	})
+}
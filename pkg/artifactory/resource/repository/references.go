@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/configuration"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/security"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+)
+
+type referenceKind string
+
+const (
+	proxyReference       referenceKind = "proxy"
+	certificateReference referenceKind = "certificate"
+	propertySetReference referenceKind = "property set"
+	layoutReference      referenceKind = "repository layout"
+)
+
+// KeypairTypes is the key pair type each package type signs its metadata with.
+var KeypairTypes = map[string]string{
+	"alpine": "RSA",
+	"debian": "GPG",
+	"rpm":    "GPG",
+}
+
+type systemConfiguration struct {
+	configuration.Proxies
+	configuration.PropertySets
+	configuration.Layouts
+}
+
+// referenceChecker looks up referenced objects, fetching the system configuration at most once per check.
+type referenceChecker struct {
+	meta   utilsdk.ProvderMetadata
+	config *systemConfiguration
+}
+
+func (c *referenceChecker) systemConfiguration() (*systemConfiguration, error) {
+	if c.config != nil {
+		return c.config, nil
+	}
+
+	config := systemConfiguration{}
+	resp, err := c.meta.Client.R().SetResult(&config).Get("artifactory/api/system/configuration")
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve data from API: /artifactory/api/system/configuration during apply: %s", err)
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("got error response for API: /artifactory/api/system/configuration request during apply: %s", resp.String())
+	}
+
+	c.config = &config
+	return c.config, nil
+}
+
+func (c *referenceChecker) exists(kind referenceKind, name string) (bool, error) {
+	switch kind {
+	case certificateReference:
+		certificate, err := security.FindCertificate(name, c.meta)
+		return certificate != nil, err
+	}
+
+	config, err := c.systemConfiguration()
+	if err != nil {
+		return false, err
+	}
+	switch kind {
+	case proxyReference:
+		return configuration.FindConfigurationById[configuration.Proxy](config.Proxies.Proxies, name) != nil, nil
+	case propertySetReference:
+		return configuration.FindConfigurationById[configuration.PropertySet](config.PropertySets.PropertySets, name) != nil, nil
+	case layoutReference:
+		return configuration.FindConfigurationById[configuration.Layout](config.Layouts.Layouts, name) != nil, nil
+	}
+
+	return false, fmt.Errorf("unsupported reference kind %s", kind)
+}
+
+func (c *referenceChecker) keypairType(name string) (string, bool, error) {
+	keypair := security.KeyPairPayLoad{}
+	resp, err := c.meta.Client.R().SetResult(&keypair).Get(security.KeypairEndPoint + name)
+	if resp != nil && resp.StatusCode() == http.StatusNotFound {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+
+	return keypair.PairType, true, nil
+}
+
+// MkVerifyReferences wraps the create or update of a repository to first check the names in `proxy`,
+// `client_tls_certificate`, `primary_keypair_ref`/`secondary_keypair_ref`, `property_sets`, `repo_layout_ref` and
+// `remote_repo_layout_ref` refer to existing objects, instead of failing with an unhelpful 400. Only changed attributes
+// are checked.
+//
+// The check runs on apply rather than plan, so objects created earlier in the same apply, e.g. a key pair the
+// repository references or depends on, are found.
+//
+// The expected key pair type is derived from the package type of the repository constructor.
+func MkVerifyReferences[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](constructor Constructor, f F) F {
+	keypairType := KeypairTypes[packageTypeOf(constructor)]
+
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		checker := &referenceChecker{meta: m.(utilsdk.ProvderMetadata)}
+		var errs []string
+
+		for _, ref := range []struct {
+			attribute string
+			kind      referenceKind
+		}{
+			{"proxy", proxyReference},
+			{"client_tls_certificate", certificateReference},
+			{"repo_layout_ref", layoutReference},
+			{"remote_repo_layout_ref", layoutReference},
+		} {
+			name, ok := changedString(d, ref.attribute)
+			if !ok {
+				continue
+			}
+			if err := checkExists(checker, ref.attribute, ref.kind, name); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+
+		if d.HasChange("property_sets") {
+			if propertySets, ok := d.Get("property_sets").(*schema.Set); ok {
+				for _, name := range propertySets.List() {
+					if err := checkExists(checker, "property_sets", propertySetReference, name.(string)); err != nil {
+						errs = append(errs, err.Error())
+					}
+				}
+			}
+		}
+
+		for _, attribute := range []string{"primary_keypair_ref", "secondary_keypair_ref"} {
+			name, ok := changedString(d, attribute)
+			if !ok {
+				continue
+			}
+			pairType, found, err := checker.keypairType(name)
+			switch {
+			case err != nil:
+				errs = append(errs, fmt.Sprintf("failed to verify %s %q: %s", attribute, name, err))
+			case !found:
+				errs = append(errs, fmt.Sprintf("%s %q does not exist in Artifactory, check the key pair name, or reference the key pair resource if it's created in the same apply", attribute, name))
+			case keypairType != "" && pairType != "" && !strings.EqualFold(pairType, keypairType):
+				errs = append(errs, fmt.Sprintf("%s %q is a %s key pair, a %s key pair is required", attribute, name, pairType, keypairType))
+			}
+		}
+
+		if len(errs) > 0 {
+			return diag.Errorf("%s", strings.Join(errs, "\n"))
+		}
+		return f(ctx, d, m)
+	}
+}
+
+// changedString returns the new value of a string attribute when it's set and changed.
+func changedString(d *schema.ResourceData, attribute string) (string, bool) {
+	if !d.HasChange(attribute) {
+		return "", false
+	}
+	name, _ := d.Get(attribute).(string)
+	return name, name != ""
+}
+
+func checkExists(checker *referenceChecker, attribute string, kind referenceKind, name string) error {
+	found, err := checker.exists(kind, name)
+	if err != nil {
+		return fmt.Errorf("failed to verify %s %q: %s", attribute, name, err)
+	}
+	if !found {
+		return fmt.Errorf("%s %q does not exist in Artifactory, check the %s name, or reference the %s resource if it's created in the same apply", attribute, name, kind, kind)
+	}
+	return nil
+}
+
+func packageTypeOf(constructor Constructor) string {
+	repo, err := constructor()
+	if err != nil {
+		return ""
+	}
+
+	body, err := json.Marshal(repo)
+	if err != nil {
+		return ""
+	}
+
+	base := struct {
+		PackageType string `json:"packageType"`
+	}{}
+	if err := json.Unmarshal(body, &base); err != nil {
+		return ""
+	}
+
+	return base.PackageType
+}
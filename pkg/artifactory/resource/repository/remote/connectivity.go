@@ -0,0 +1,181 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+)
+
+const (
+	VerifyConnectivityWarning = "warning"
+	VerifyConnectivityError   = "error"
+)
+
+// RemoteRepositoryTestEndpoint is the endpoint behind the "Test" button of the remote repository form in the
+// Artifactory UI. Artifactory connects to the remote URL with the given network settings, the same way it does when
+// resolving artifacts. It's not part of the public REST API, there's no public equivalent, so it may change between
+// Artifactory versions.
+const RemoteRepositoryTestEndpoint = "artifactory/ui/admin/repositories/testremote"
+
+var verifyConnectivitySchema = map[string]*schema.Schema{
+	"verify_connectivity": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{VerifyConnectivityWarning, VerifyConnectivityError}, false)),
+		Description: "Test the connection to `url` after the repository is created or updated, using the saved configuration, " +
+			"credentials and proxy. A failed handshake, authentication error or DNS failure is reported as a warning with `warning`, " +
+			"or fails the apply with `error`, in which case the repository is tainted. Not set by default, no test is done. " +
+			"The test uses the internal endpoint of the \"Test\" button of the Artifactory UI, which isn't part of the public REST API " +
+			"and may change between Artifactory versions.",
+	},
+}
+
+type remoteTestNetwork struct {
+	Url                          string `json:"url"`
+	Username                     string `json:"username,omitempty"`
+	Password                     string `json:"password,omitempty"`
+	Proxy                        string `json:"proxy,omitempty"`
+	SocketTimeout                int    `json:"socketTimeout,omitempty"`
+	LocalAddress                 string `json:"localAddress,omitempty"`
+	LenientHostAuth              bool   `json:"lenientHostAuth"`
+	CookieManagement             bool   `json:"cookieManagement"`
+	SelectedInstalledCertificate string `json:"selectedInstalledCertificate,omitempty"`
+}
+
+type remoteTestRequest struct {
+	Type    string `json:"type"`
+	General struct {
+		RepoKey string `json:"repoKey"`
+	} `json:"general"`
+	Basic struct {
+		Url string `json:"url"`
+	} `json:"basic"`
+	Advanced struct {
+		Network remoteTestNetwork `json:"network"`
+	} `json:"advanced"`
+	TypeSpecific struct {
+		RepoType string `json:"repoType"`
+	} `json:"typeSpecific"`
+}
+
+type remoteTestResponse struct {
+	Info   string `json:"info"`
+	Error  string `json:"error"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func (r remoteTestResponse) message() string {
+	if r.Error != "" {
+		return r.Error
+	}
+	var messages []string
+	for _, e := range r.Errors {
+		messages = append(messages, e.Message)
+	}
+	return strings.Join(messages, ", ")
+}
+
+// testConnectivity asks Artifactory to connect to the remote URL of the saved repository. The password is never
+// returned by the API so it's taken from the configuration.
+func testConnectivity(d *schema.ResourceData, m interface{}) error {
+	client := m.(utilsdk.ProvderMetadata).Client
+
+	saved := RepositoryRemoteBaseParams{}
+	_, err := client.R().
+		SetResult(&saved).
+		SetPathParam("key", d.Id()).
+		Get(repository.RepositoriesEndpoint)
+	if err != nil {
+		return fmt.Errorf("failed to read repository %s: %s", d.Id(), err)
+	}
+
+	request := remoteTestRequest{Type: "remoteRepoConfig"}
+	request.General.RepoKey = saved.Key
+	request.Basic.Url = saved.Url
+	request.TypeSpecific.RepoType = repoTypeOf(saved.PackageType)
+	request.Advanced.Network = remoteTestNetwork{
+		Url:                          saved.Url,
+		Username:                     saved.Username,
+		Password:                     d.Get("password").(string),
+		SocketTimeout:                saved.SocketTimeoutMillis,
+		LocalAddress:                 saved.LocalAddress,
+		SelectedInstalledCertificate: saved.ClientTlsCertificate,
+	}
+	if !saved.DisableProxy {
+		request.Advanced.Network.Proxy = saved.Proxy
+	}
+	if saved.AllowAnyHostAuth != nil {
+		request.Advanced.Network.LenientHostAuth = *saved.AllowAnyHostAuth
+	}
+	if saved.EnableCookieManagement != nil {
+		request.Advanced.Network.CookieManagement = *saved.EnableCookieManagement
+	}
+
+	// the client returns an error for any failed response, the reason of the failure is in its body
+	result := remoteTestResponse{}
+	resp, err := client.R().
+		SetBody(request).
+		SetError(&result).
+		Post(RemoteRepositoryTestEndpoint)
+	if err != nil {
+		if resp == nil || !resp.IsError() {
+			return fmt.Errorf("failed to test connection to %s: %s", saved.Url, err)
+		}
+		message := result.message()
+		if message == "" {
+			message = err.Error()
+		}
+		return fmt.Errorf("connection to %s failed: %s", saved.Url, message)
+	}
+
+	return nil
+}
+
+// repoTypeOf converts a package type to the repository type names used by the UI, e.g. `maven` to `Maven`.
+func repoTypeOf(packageType string) string {
+	if packageType == "" {
+		return ""
+	}
+	return strings.ToUpper(packageType[:1]) + packageType[1:]
+}
+
+// mkVerifyConnectivity wraps a create or update function to test the connection of the remote repository afterwards,
+// if `verify_connectivity` is set.
+func mkVerifyConnectivity[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](f F) F {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := f(ctx, d, m)
+		if diags.HasError() {
+			return diags
+		}
+
+		mode, _ := d.Get("verify_connectivity").(string)
+		if mode == "" {
+			return diags
+		}
+
+		err := testConnectivity(d, m)
+		if err == nil {
+			tflog.Debug(ctx, "remote repository connectivity verified", map[string]interface{}{"key": d.Id()})
+			return diags
+		}
+
+		severity := diag.Warning
+		if mode == VerifyConnectivityError {
+			severity = diag.Error
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  fmt.Sprintf("Remote repository %s failed the connectivity test", d.Id()),
+			Detail:   err.Error(),
+		})
+	}
+}
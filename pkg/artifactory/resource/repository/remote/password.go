@@ -0,0 +1,27 @@
+package remote
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+)
+
+var passwordVersionSchema = map[string]*schema.Schema{
+	"password_version": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		Description: "Change this value, e.g. increment it, to send `password` to Artifactory again without changing it, " +
+			"so the rotation of the upstream credentials shows up in the plan.",
+	},
+}
+
+// unpackPassword returns the password to send to Artifactory. It's only sent when the repository is created, or when
+// `password` or `password_version` changed, so an update of other attributes doesn't overwrite the password set in
+// Artifactory. An empty password is omitted from the request and Artifactory keeps the current one.
+func unpackPassword(d *utilsdk.ResourceData) string {
+	if d.Id() != "" && !d.HasChanges("password", "password_version") {
+		return ""
+	}
+	return d.GetString("password", false)
+}
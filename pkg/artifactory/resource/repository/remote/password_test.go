@@ -0,0 +1,67 @@
+package remote_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+// mkUpdateResourceData returns the resource data of an update of a repository created with config to newConfig.
+func mkUpdateResourceData(t *testing.T, r *schema.Resource, config, newConfig map[string]interface{}) *schema.ResourceData {
+	created := schema.TestResourceDataRaw(t, r.Schema, config)
+	created.SetId(config["key"].(string))
+	state := created.State()
+
+	diff, err := schema.InternalMap(r.Schema).Diff(context.Background(), state, terraform.NewResourceConfigRaw(newConfig), nil, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestUnpackBaseRemoteRepo_password(t *testing.T) {
+	r := remote.ResourceArtifactoryRemoteGenericRepository()
+	config := map[string]interface{}{
+		"key":              "generic-remote",
+		"url":              "https://releases.jfrog.io/artifactory/",
+		"username":         "admin",
+		"password":         "Passw0rd!",
+		"password_version": 1,
+	}
+	with := func(key string, value interface{}) map[string]interface{} {
+		newConfig := map[string]interface{}{}
+		for k, v := range config {
+			newConfig[k] = v
+		}
+		newConfig[key] = value
+		return newConfig
+	}
+
+	t.Run("sent on create", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, r.Schema, config)
+		assert.Equal(t, "Passw0rd!", remote.UnpackBaseRemoteRepo(d, "generic").Password)
+	})
+
+	for _, testCase := range []struct {
+		name      string
+		newConfig map[string]interface{}
+		expected  string
+	}{
+		{name: "not sent when unchanged", newConfig: with("description", "updated"), expected: ""},
+		{name: "sent when changed", newConfig: with("password", "N3wPassw0rd!"), expected: "N3wPassw0rd!"},
+		{name: "sent when the version is changed", newConfig: with("password_version", 2), expected: "Passw0rd!"},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			d := mkUpdateResourceData(t, r, config, testCase.newConfig)
+			assert.Equal(t, testCase.expected, remote.UnpackBaseRemoteRepo(d, "generic").Password)
+		})
+	}
+}
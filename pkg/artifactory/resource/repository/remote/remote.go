@@ -104,7 +104,7 @@ var PackageTypesLikeBasic = []string{
 }
 
 var BaseRemoteRepoSchema = func(isResource bool) map[string]*schema.Schema {
-	return utilsdk.MergeMaps(
+	skeema := utilsdk.MergeMaps(
 		repository.BaseRepoSchema,
 		map[string]*schema.Schema{
 			"url": {
@@ -371,6 +371,12 @@ var BaseRemoteRepoSchema = func(isResource bool) map[string]*schema.Schema {
 			},
 		},
 	)
+
+	if isResource {
+		skeema = utilsdk.MergeMaps(skeema, verifyConnectivitySchema, passwordVersionSchema)
+	}
+
+	return skeema
 }
 
 var baseRemoteRepoSchemaV1 = utilsdk.MergeMaps(
@@ -471,7 +477,7 @@ func UnpackBaseRemoteRepo(s *schema.ResourceData, packageType string) Repository
 		PackageType:                       packageType, // must be set independently
 		Url:                               d.GetString("url", false),
 		Username:                          d.GetString("username", false),
-		Password:                          d.GetString("password", false),
+		Password:                          unpackPassword(d),
 		Proxy:                             d.GetString("proxy", false),
 		DisableProxy:                      d.GetBool("disable_proxy", false),
 		Description:                       d.GetString("description", false),
@@ -558,9 +564,9 @@ var resourceV1 = &schema.Resource{
 func mkResourceSchema(skeema map[string]*schema.Schema, packer packer.PackFunc, unpack unpacker.UnpackFunc, constructor repository.Constructor) *schema.Resource {
 	var reader = repository.MkRepoRead(packer, constructor)
 	return &schema.Resource{
-		CreateContext: repository.MkRepoCreate(unpack, reader),
+		CreateContext: mkVerifyConnectivity(repository.MkVerifyReferences(constructor, repository.MkRepoCreate(unpack, reader))),
 		ReadContext:   reader,
-		UpdateContext: repository.MkRepoUpdate(unpack, reader),
+		UpdateContext: mkVerifyConnectivity(repository.MkVerifyReferences(constructor, repository.MkRepoUpdate(unpack, reader))),
 		DeleteContext: repository.DeleteRepo,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
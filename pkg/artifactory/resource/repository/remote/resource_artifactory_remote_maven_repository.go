@@ -55,9 +55,9 @@ var mavenRemoteSchemaV1 = utilsdk.MergeMaps(
 func mkResourceSchemaMaven(skeema map[string]*schema.Schema, packer packer.PackFunc, unpack unpacker.UnpackFunc, constructor repository.Constructor) *schema.Resource {
 	var reader = repository.MkRepoRead(packer, constructor)
 	return &schema.Resource{
-		CreateContext: repository.MkRepoCreate(unpack, reader),
+		CreateContext: mkVerifyConnectivity(repository.MkVerifyReferences(constructor, repository.MkRepoCreate(unpack, reader))),
 		ReadContext:   reader,
-		UpdateContext: repository.MkRepoUpdate(unpack, reader),
+		UpdateContext: mkVerifyConnectivity(repository.MkVerifyReferences(constructor, repository.MkRepoUpdate(unpack, reader))),
 		DeleteContext: repository.DeleteRepo,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
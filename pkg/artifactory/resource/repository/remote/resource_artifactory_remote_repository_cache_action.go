@@ -0,0 +1,288 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/workerpool"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+)
+
+// ZapCacheEndpoint is the endpoint behind the "Zap Caches" action of the Artifactory UI. Zapping a remote repository
+// expires the cached artifacts and metadata under a path, so they are checked against the remote URL on the next
+// request.
+const ZapCacheEndpoint = "artifactory/ui/artifactactions/zap"
+
+const (
+	CacheActionZap     = "zap"
+	CacheActionExpire  = "expire"
+	CacheActionPrewarm = "prewarm"
+)
+
+var cacheActions = []string{"zap", "expire", "prewarm_paths"}
+
+var cacheActionSchema = map[string]*schema.Schema{
+	"repo_key": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		Description:      "Key of the remote repository.",
+	},
+	"path": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     "",
+		Description: "Path prefix in the repository the cache is zapped or expired for, e.g. `org/acme/`. Default to the root of the repository.",
+	},
+	"zap": {
+		Type:         schema.TypeBool,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      false,
+		AtLeastOneOf: cacheActions,
+		Description:  "Zap the cached artifacts and metadata under `path`, so they are checked against the remote URL on the next request. Default to `false`.",
+	},
+	"expire": {
+		Type:         schema.TypeBool,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      false,
+		AtLeastOneOf: cacheActions,
+		Description:  "Expire the cached artifacts and metadata under `path` by deleting them from the cache of the repository, so they are downloaded again from the remote URL on the next request. Default to `false`.",
+	},
+	"prewarm_paths": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		Elem:         &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty)},
+		AtLeastOneOf: cacheActions,
+		Description:  "Paths of artifacts to download through the repository after the cache is zapped or expired, so they are cached ahead of the first request, e.g. `org/acme/foo/1.0/foo-1.0.jar`.",
+	},
+	"parallelism": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		ForceNew:         true,
+		Default:          4,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 32)),
+		Description:      "Number of `prewarm_paths` downloaded in parallel. Default to `4`.",
+	},
+	"triggers": {
+		Type:        schema.TypeMap,
+		Optional:    true,
+		ForceNew:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Arbitrary map of values that, when changed, runs the actions again.",
+	},
+	"results": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "`zap`, `expire` or `prewarm`.",
+				},
+				"path": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Path the action was run for.",
+				},
+				"status_code": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "HTTP status code returned by Artifactory, `0` if the request failed.",
+				},
+				"error": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Error message if the action failed.",
+				},
+			},
+		},
+		Description: "Result of each action, the zap and the expiration first, then one per path of `prewarm_paths` in the same order.",
+	},
+}
+
+type cacheActionResult struct {
+	Action     string
+	Path       string
+	StatusCode int
+	Error      string
+}
+
+func (r cacheActionResult) failed() bool {
+	return r.Error != ""
+}
+
+func ResourceArtifactoryRemoteRepositoryCacheAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCacheActionCreate,
+		ReadContext:   resourceCacheActionRead,
+		DeleteContext: resourceCacheActionDelete,
+
+		Schema:      cacheActionSchema,
+		Description: "Zaps or expires the cache of a remote repository and pre-warms it by downloading a list of artifacts. The actions run when the resource is created, and again whenever an argument, e.g. `triggers`, changes. Deleting the resource only removes it from the state.",
+	}
+}
+
+// escapePath escapes each segment of a repository path, so names with e.g. `#` or spaces are requested as is.
+func escapePath(path string) string {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func zapCache(client utilsdk.ProvderMetadata, repoKey, path string) cacheActionResult {
+	result := cacheActionResult{Action: CacheActionZap, Path: path}
+
+	resp, err := client.Client.R().
+		SetBody(map[string]string{"repoKey": repoKey, "path": path}).
+		Post(ZapCacheEndpoint)
+	if resp != nil {
+		result.StatusCode = resp.StatusCode()
+	}
+	if err != nil {
+		result.Error = err.Error()
+	} else if resp.IsError() {
+		result.Error = resp.String()
+	}
+	return result
+}
+
+// expireCache deletes the cached items under path from the cache of the repository, `<repo_key>-cache`.
+func expireCache(client utilsdk.ProvderMetadata, repoKey, path string) cacheActionResult {
+	result := cacheActionResult{Action: CacheActionExpire, Path: path}
+
+	resp, err := client.Client.R().
+		Delete(fmt.Sprintf("artifactory/%s-cache/%s", repoKey, escapePath(path)))
+	if resp != nil {
+		result.StatusCode = resp.StatusCode()
+	}
+	// nothing is cached under path yet
+	if err != nil && resp != nil && resp.StatusCode() == http.StatusNotFound {
+		return result
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+func prewarmCache(client utilsdk.ProvderMetadata, repoKey, path string) cacheActionResult {
+	result := cacheActionResult{Action: CacheActionPrewarm, Path: path}
+
+	resp, err := client.Client.R().
+		SetDoNotParseResponse(true).
+		Get(fmt.Sprintf("artifactory/%s/%s", repoKey, escapePath(path)))
+	if resp != nil {
+		result.StatusCode = resp.StatusCode()
+		if body := resp.RawBody(); body != nil {
+			// the artifact is only cached once it's fully downloaded
+			if _, err := io.Copy(io.Discard, body); err != nil && result.Error == "" {
+				result.Error = fmt.Sprintf("failed to download %s: %s", path, err)
+			}
+			body.Close()
+		}
+	}
+	if err != nil {
+		result.Error = err.Error()
+	} else if result.StatusCode != http.StatusOK {
+		result.Error = fmt.Sprintf("failed to download %s: %s", path, resp.Status())
+	}
+	return result
+}
+
+func resourceCacheActionCreate(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	data := &utilsdk.ResourceData{ResourceData: d}
+	client := m.(utilsdk.ProvderMetadata)
+	repoKey := data.GetString("repo_key", false)
+
+	repo := RepositoryRemoteBaseParams{}
+	_, err := client.Client.R().
+		SetResult(&repo).
+		SetPathParam("key", repoKey).
+		Get(repository.RepositoriesEndpoint)
+	if err != nil {
+		return diag.Errorf("failed to read repository %s: %s", repoKey, err)
+	}
+	if repo.Rclass != "remote" {
+		return diag.Errorf("repository %s is a %s repository, only remote repositories are supported by this resource", repoKey, repo.Rclass)
+	}
+
+	var results []cacheActionResult
+	if data.GetBool("zap", false) {
+		result := zapCache(client, repoKey, data.GetString("path", false))
+		if result.failed() {
+			return diag.Errorf("failed to zap the cache of repository %s: %s", repoKey, result.Error)
+		}
+		results = append(results, result)
+	}
+	if data.GetBool("expire", false) {
+		result := expireCache(client, repoKey, data.GetString("path", false))
+		if result.failed() {
+			return diag.Errorf("failed to expire the cache of repository %s: %s", repoKey, result.Error)
+		}
+		results = append(results, result)
+	}
+
+	paths := utilsdk.CastToStringArr(d.Get("prewarm_paths").([]interface{}))
+	// a failed pre-warm is only a warning, its error is part of its result
+	prewarmed, _ := workerpool.Run(paths, data.GetInt("parallelism", false), func(path string) (cacheActionResult, error) {
+		return prewarmCache(client, repoKey, path), nil
+	})
+	results = append(results, prewarmed...)
+
+	var diags diag.Diagnostics
+	for _, result := range prewarmed {
+		if result.failed() {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Failed to pre-warm %s in repository %s", result.Path, repoKey),
+				Detail:   result.Error,
+			})
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", repoKey, time.Now().UnixNano()))
+
+	packed := make([]map[string]interface{}, 0, len(results))
+	for _, result := range results {
+		packed = append(packed, map[string]interface{}{
+			"action":      result.Action,
+			"path":        result.Path,
+			"status_code": result.StatusCode,
+			"error":       result.Error,
+		})
+	}
+	setValue := utilsdk.MkLens(d)
+	errors := setValue("results", packed)
+	if errors != nil && len(errors) > 0 {
+		return append(diags, diag.Errorf("failed to pack cache action results %q", errors)...)
+	}
+
+	return diags
+}
+
+// resourceCacheActionRead keeps the state as is, the actions have no remote state to read.
+func resourceCacheActionRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceCacheActionDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,156 @@
+package remote_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository/remote"
+	"github.com/jfrog/terraform-provider-shared/client"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteRepositoryCacheAction_requiresAnAction(t *testing.T) {
+	r := remote.ResourceArtifactoryRemoteRepositoryCacheAction()
+
+	diags := r.Validate(sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+		"repo_key": "maven-remote",
+		"path":     "org/acme/",
+	}))
+	assert.True(t, diags.HasError())
+
+	diags = r.Validate(sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+		"repo_key": "maven-remote",
+		"expire":   true,
+	}))
+	assert.False(t, diags.HasError(), "%v", diags)
+}
+
+func TestRemoteRepositoryCacheAction_escapedPaths(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/artifactory/api/repositories/maven-remote" {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"key":"maven-remote","rclass":"remote"}`))
+			return
+		}
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	restyClient, err := client.Build(server.URL, "terraform-provider-artifactory/test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	restyClient, err = client.AddAuth(restyClient, "", "test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := remote.ResourceArtifactoryRemoteRepositoryCacheAction()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"repo_key":      "maven-remote",
+		"path":          "org/acme #1/",
+		"expire":        true,
+		"prewarm_paths": []interface{}{"org/acme #1/foo?.jar"},
+	})
+	diags := r.CreateContext(context.Background(), d, utilsdk.ProvderMetadata{Client: restyClient})
+	assert.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, []string{
+		"DELETE /artifactory/maven-remote-cache/org/acme%20%231/",
+		"GET /artifactory/maven-remote/org/acme%20%231/foo%3F.jar",
+	}, requests)
+	assert.Equal(t, "expire", d.Get("results.0.action"))
+	assert.Equal(t, "prewarm", d.Get("results.1.action"))
+}
+
+func TestAccRemoteRepositoryCacheAction(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("tf-cache-action-", "artifactory_remote_repository_cache_action")
+	_, repoFqrn, repoName := testutil.MkNames("tf-maven-remote-", "artifactory_remote_maven_repository")
+	const template = `
+		resource "artifactory_remote_maven_repository" "{{ .repo_name }}" {
+			key = "{{ .repo_name }}"
+			url = "https://repo1.maven.org/maven2/"
+		}
+
+		resource "artifactory_remote_repository_cache_action" "{{ .name }}" {
+			repo_key      = artifactory_remote_maven_repository.{{ .repo_name }}.key
+			path          = "org/apache/commons/"
+			zap           = true
+			prewarm_paths = [
+				"org/apache/commons/commons-lang3/3.12.0/commons-lang3-3.12.0.pom",
+				"org/apache/commons/commons-lang3/0.0.0/commons-lang3-0.0.0.pom",
+			]
+			parallelism   = 2
+			triggers      = {
+				incident = "{{ .incident }}"
+			}
+		}
+	`
+
+	testData := map[string]string{
+		"name":      name,
+		"repo_name": repoName,
+		"incident":  "1",
+	}
+	updatedTestData := map[string]string{
+		"name":      name,
+		"repo_name": repoName,
+		"incident":  "2",
+	}
+
+	var id string
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(repoFqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: utilsdk.ExecuteTemplate("TestAccRemoteRepositoryCacheAction", template, testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(fqrn, "id", regexp.MustCompile("^"+repoName+":")),
+					resource.TestCheckResourceAttr(fqrn, "results.#", "3"),
+					resource.TestCheckResourceAttr(fqrn, "results.0.action", "zap"),
+					resource.TestCheckResourceAttr(fqrn, "results.0.path", "org/apache/commons/"),
+					resource.TestCheckResourceAttr(fqrn, "results.0.error", ""),
+					resource.TestCheckResourceAttr(fqrn, "results.1.action", "prewarm"),
+					resource.TestCheckResourceAttr(fqrn, "results.1.status_code", "200"),
+					resource.TestCheckResourceAttr(fqrn, "results.1.error", ""),
+					resource.TestCheckResourceAttr(fqrn, "results.2.action", "prewarm"),
+					resource.TestCheckResourceAttr(fqrn, "results.2.status_code", "404"),
+					resource.TestCheckResourceAttrSet(fqrn, "results.2.error"),
+					resource.TestCheckResourceAttrWith(fqrn, "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: utilsdk.ExecuteTemplate("TestAccRemoteRepositoryCacheAction", template, updatedTestData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "triggers.incident", "2"),
+					resource.TestCheckResourceAttrWith(fqrn, "id", func(value string) error {
+						if value == id {
+							return fmt.Errorf("expected the actions to run again when triggers change")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
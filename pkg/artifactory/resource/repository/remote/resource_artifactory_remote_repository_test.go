@@ -1635,3 +1635,104 @@ func TestAccRemoteRepository_excludes_pattern_reset(t *testing.T) {
 		},
 	})
 }
+
+func TestAccRemoteRepository_missing_references(t *testing.T) {
+	_, _, name := testutil.MkNames("tf-generic-remote-", "artifactory_remote_generic_repository")
+	config := utilsdk.ExecuteTemplate("TestAccRemoteGenericRepository", `
+		resource "artifactory_remote_generic_repository" "{{ .name }}" {
+			key                    = "{{ .name }}"
+			url                    = "https://example.com"
+			proxy                  = "{{ .name }}-missing-proxy"
+			client_tls_certificate = "{{ .name }}-missing-certificate"
+			repo_layout_ref        = "{{ .name }}-missing-layout"
+		}
+	`, map[string]string{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*proxy "` + name + `-missing-proxy" does not exist.*`),
+			},
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*client_tls_certificate "` + name + `-missing-certificate" does not exist.*`),
+			},
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*repo_layout_ref "` + name + `-missing-layout" does not exist.*`),
+			},
+		},
+	})
+}
+
+func TestAccRemoteRepository_verify_connectivity(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("tf-generic-remote-", "artifactory_remote_generic_repository")
+	const template = `
+		resource "artifactory_remote_generic_repository" "{{ .name }}" {
+			key                 = "{{ .name }}"
+			url                 = "{{ .url }}"
+			verify_connectivity = "{{ .mode }}"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: utilsdk.ExecuteTemplate("TestAccRemoteGenericRepository", template, map[string]string{
+					"name": name,
+					"url":  "https://releases.jfrog.io/artifactory/",
+					"mode": "error",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "verify_connectivity", "error"),
+				),
+			},
+			{
+				Config: utilsdk.ExecuteTemplate("TestAccRemoteGenericRepository", template, map[string]string{
+					"name": name,
+					"url":  "https://" + name + ".invalid/",
+					"mode": "error",
+				}),
+				ExpectError: regexp.MustCompile(".*failed the connectivity test.*"),
+			},
+		},
+	})
+}
+
+func TestAccRemoteRepository_password_version(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("tf-generic-remote-", "artifactory_remote_generic_repository")
+	const template = `
+		resource "artifactory_remote_generic_repository" "{{ .name }}" {
+			key              = "{{ .name }}"
+			url              = "https://releases.jfrog.io/artifactory/"
+			username         = "admin"
+			password         = "Passw0rd!"
+			password_version = {{ .version }}
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: utilsdk.ExecuteTemplate("TestAccRemoteGenericRepository", template, map[string]string{"name": name, "version": "1"}),
+				Check:  resource.TestCheckResourceAttr(fqrn, "password_version", "1"),
+			},
+			{
+				Config: utilsdk.ExecuteTemplate("TestAccRemoteGenericRepository", template, map[string]string{"name": name, "version": "2"}),
+				Check:  resource.TestCheckResourceAttr(fqrn, "password_version", "2"),
+			},
+		},
+	})
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/antpath"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/configuration"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	"golang.org/x/exp/slices"
 
@@ -48,7 +50,8 @@ var BaseRepoSchema = map[string]*schema.Schema{
 		Optional: true,
 		Computed: true,
 		Description: "Project environment for assigning this repository to. Allow values: \"DEV\", \"PROD\", or one of custom environment. " +
-			"Before Artifactory 7.53.1, up to 2 values (\"DEV\" and \"PROD\") are allowed. From 7.53.1 onward, only one value is allowed. " +
+			"Before Artifactory 7.53.1, up to 2 values (\"DEV\" and \"PROD\") are allowed. From 7.53.1 onward, only one value is allowed, " +
+			"and a custom environment must exist globally or in the project of the repository. " +
 			"The attribute should only be used if the repository is already assigned to the existing project. If not, " +
 			"the attribute will be ignored by Artifactory, but will remain in the Terraform state, which will create " +
 			"state drift during the update.",
@@ -70,15 +73,17 @@ var BaseRepoSchema = map[string]*schema.Schema{
 		Description: "Internal description.",
 	},
 	"includes_pattern": {
-		Type:     schema.TypeString,
-		Optional: true,
-		Default:  "**/*",
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "**/*",
+		ValidateDiagFunc: antpath.ValidatePatternList,
 		Description: "List of comma-separated artifact patterns to include when evaluating artifact requests in the form of x/y/**/z/*. " +
 			"When used, only artifacts matching one of the include patterns are served. By default, all artifacts are included (**/*).",
 	},
 	"excludes_pattern": {
-		Type:     schema.TypeString,
-		Optional: true,
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: antpath.ValidateExcludesPatternList,
 		Description: "List of artifact patterns to exclude when evaluating artifact requests, in the form of x/y/**/z/*." +
 			"By default no artifacts are excluded.",
 	},
@@ -129,6 +134,11 @@ type Constructor func() (interface{}, error)
 func MkRepoCreate(unpack unpacker.UnpackFunc, read schema.ReadContextFunc) schema.CreateContextFunc {
 
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := verifyProjectEnvironmentsExist(d, m)
+		if diags.HasError() {
+			return diags
+		}
+
 		repo, key, err := unpack(d)
 		if err != nil {
 			return diag.FromErr(err)
@@ -144,7 +154,7 @@ func MkRepoCreate(unpack unpacker.UnpackFunc, read schema.ReadContextFunc) schem
 			return diag.FromErr(err)
 		}
 		d.SetId(key)
-		return read(ctx, d, m)
+		return append(diags, read(ctx, d, m)...)
 	}
 }
 
@@ -174,6 +184,14 @@ func MkRepoRead(pack packer.PackFunc, construct Constructor) schema.ReadContextF
 
 func MkRepoUpdate(unpack unpacker.UnpackFunc, read schema.ReadContextFunc) schema.UpdateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		if d.HasChanges("project_environments", "project_key") {
+			diags = verifyProjectEnvironmentsExist(d, m)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
 		repo, key, err := unpack(d)
 		if err != nil {
 			return diag.FromErr(err)
@@ -214,7 +232,7 @@ func MkRepoUpdate(unpack unpacker.UnpackFunc, read schema.ReadContextFunc) schem
 			}
 		}
 
-		return read(ctx, d, m)
+		return append(diags, read(ctx, d, m)...)
 	}
 }
 
@@ -361,12 +379,58 @@ func ProjectEnvironmentsDiff(ctx context.Context, diff *schema.ResourceDiff, met
 	return nil
 }
 
+// verifyProjectEnvironmentsExist verifies each custom environment exists globally or in the project of the repository,
+// so a typo doesn't silently drift. It's verified when applying, as the environment may be created in the same apply,
+// e.g. with `artifactory_global_environment`.
+func verifyProjectEnvironmentsExist(d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	projectEnvironments, ok := d.Get("project_environments").(*schema.Set)
+	if !ok || projectEnvironments.Len() == 0 {
+		return nil
+	}
+
+	providerMetadata := m.(utilsdk.ProvderMetadata)
+	if isSupported, err := utilsdk.CheckVersion(providerMetadata.ArtifactoryVersion, CustomProjectEnvironmentSupportedVersion); err != nil || !isSupported {
+		return nil
+	}
+
+	projectKey, _ := d.Get("project_key").(string)
+	if projectKey == defaultProjectKey {
+		projectKey = ""
+	}
+
+	var environments []string
+	for _, projectEnvironment := range projectEnvironments.List() {
+		if slices.Contains(ProjectEnvironmentsSupported, projectEnvironment.(string)) {
+			continue
+		}
+
+		if environments == nil {
+			var err error
+			environments, err = configuration.GetEnvironments(providerMetadata.Client, projectKey)
+			if err != nil {
+				// e.g. the token isn't allowed to list the environments
+				return diag.Diagnostics{{
+					Severity: diag.Warning,
+					Summary:  "project_environments can't be verified",
+					Detail:   err.Error(),
+				}}
+			}
+		}
+
+		if !slices.Contains(environments, projectEnvironment.(string)) {
+			return diag.Errorf("project_environment %s doesn't exist globally or in project %s", projectEnvironment, d.Get("project_key"))
+		}
+	}
+
+	return nil
+}
+
 func MkResourceSchema(skeema map[string]*schema.Schema, packer packer.PackFunc, unpack unpacker.UnpackFunc, constructor Constructor) *schema.Resource {
 	var reader = MkRepoRead(packer, constructor)
 	return &schema.Resource{
-		CreateContext: MkRepoCreate(unpack, reader),
+		CreateContext: MkVerifyReferences(constructor, MkRepoCreate(unpack, reader)),
 		ReadContext:   reader,
-		UpdateContext: MkRepoUpdate(unpack, reader),
+		UpdateContext: MkVerifyReferences(constructor, MkRepoUpdate(unpack, reader)),
 		DeleteContext: DeleteRepo,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
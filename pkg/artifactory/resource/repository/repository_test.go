@@ -255,3 +255,28 @@ func TestAccRepository_invalid_project_environments_after_7_53_1(t *testing.T) {
 		},
 	})
 }
+
+func TestAccRepository_includes_pattern_with_regex_characters(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("regex-pattern-generic-local", "artifactory_local_generic_repository")
+
+	localRepositoryBasic := utilsdk.ExecuteTemplate("TestAccLocalGenericRepository", `
+		resource "artifactory_local_generic_repository" "{{ .name }}" {
+		  key              = "{{ .name }}"
+		  includes_pattern = "**/*,lib(1).jar"
+		}
+	`, map[string]interface{}{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: localRepositoryBasic,
+				Check:  resource.TestCheckResourceAttr(fqrn, "includes_pattern", "**/*,lib(1).jar"),
+			},
+		},
+	})
+}
@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+	"github.com/jfrog/terraform-provider-shared/validator"
+)
+
+const (
+	ShareRepositoryEndpoint        = "access/api/v1/projects/_/share/repositories/{repoKey}/{projectKey}"
+	ShareRepositoryWithAllEndpoint = "access/api/v1/projects/_/share/repositories/{repoKey}"
+)
+
+func ResourceArtifactoryRepositoryProjectShare() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRepositoryProjectShareCreate,
+		ReadContext:   resourceRepositoryProjectShareRead,
+		UpdateContext: resourceRepositoryProjectShareUpdate,
+		DeleteContext: resourceRepositoryProjectShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repo_key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				Description:      "Key of the repository to share.",
+			},
+			"shared_with_projects": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validator.ProjectKey},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"share_with_all_projects"},
+				Description:   "Keys of the projects the repository is shared with.",
+			},
+			"share_with_all_projects": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Share the repository with all the projects, instead of `shared_with_projects`. Default to `false`.",
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Share the repository read-only, so the members of the projects can't deploy to it. Default to `false`.",
+			},
+		},
+		Description: "Shares a repository with other projects than the one it's assigned to, optionally read-only. The repository " +
+			"is shared with and unshared from projects as the configuration changes. Deleting the resource unshares the repository.",
+	}
+}
+
+func shareRepository(client *resty.Client, repoKey, projectKey string, readOnly bool) error {
+	request := client.R().
+		SetPathParam("repoKey", repoKey).
+		SetQueryParam("readOnly", strconv.FormatBool(readOnly))
+	var err error
+	if projectKey == "" {
+		_, err = request.Put(ShareRepositoryWithAllEndpoint)
+	} else {
+		_, err = request.SetPathParam("projectKey", projectKey).Put(ShareRepositoryEndpoint)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to share repository %s with %s: %s", repoKey, projectsName(projectKey), err)
+	}
+	return nil
+}
+
+func unshareRepository(client *resty.Client, repoKey, projectKey string) error {
+	request := client.R().SetPathParam("repoKey", repoKey)
+	var resp *resty.Response
+	var err error
+	if projectKey == "" {
+		resp, err = request.Delete(ShareRepositoryWithAllEndpoint)
+	} else {
+		resp, err = request.SetPathParam("projectKey", projectKey).Delete(ShareRepositoryEndpoint)
+	}
+	if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+		return fmt.Errorf("failed to unshare repository %s from %s: %s", repoKey, projectsName(projectKey), err)
+	}
+	return nil
+}
+
+// projectsName names the project in error messages, an empty key standing for all the projects.
+func projectsName(projectKey string) string {
+	if projectKey == "" {
+		return "all projects"
+	}
+	return "project " + projectKey
+}
+
+// sharedProjects returns the keys of the projects the repository is shared with, or a single empty key when it's
+// shared with all the projects.
+func sharedProjects(shareWithAll bool, projects interface{}) []string {
+	if shareWithAll {
+		return []string{""}
+	}
+	return utilsdk.CastToStringArr(projects.(*schema.Set).List())
+}
+
+func resourceRepositoryProjectShareCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	data := &utilsdk.ResourceData{ResourceData: d}
+	client := m.(utilsdk.ProvderMetadata).Client
+	repoKey := data.GetString("repo_key", false)
+	readOnly := data.GetBool("read_only", false)
+
+	for _, projectKey := range sharedProjects(data.GetBool("share_with_all_projects", false), d.Get("shared_with_projects")) {
+		if err := shareRepository(client, repoKey, projectKey, readOnly); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(repoKey)
+	return resourceRepositoryProjectShareRead(ctx, d, m)
+}
+
+// resourceRepositoryProjectShareRead reads the projects the repository is shared with, whether it's shared with all
+// the projects and read-only from its configuration, and removes the share from the state if the repository no longer
+// exists. The attributes the version of Artifactory doesn't return are kept as in the state, as are the projects when
+// the repository is shared with all the projects.
+func resourceRepositoryProjectShareRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	repo := struct {
+		SharedWithProjects    *[]string `json:"sharedWithProjects"`
+		SharedWithAllProjects *bool     `json:"sharedWithAllProjects"`
+		ReadOnly              *bool     `json:"readOnly"`
+	}{}
+	resp, err := m.(utilsdk.ProvderMetadata).Client.R().
+		SetResult(&repo).
+		SetPathParam("key", d.Id()).
+		Get(RepositoriesEndpoint)
+	if err != nil {
+		if resp != nil && (resp.StatusCode() == http.StatusBadRequest || resp.StatusCode() == http.StatusNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	setValue := utilsdk.MkLens(d)
+	errors := setValue("repo_key", d.Id())
+	if repo.SharedWithAllProjects != nil {
+		errors = setValue("share_with_all_projects", *repo.SharedWithAllProjects)
+	}
+	if repo.ReadOnly != nil {
+		errors = setValue("read_only", *repo.ReadOnly)
+	}
+	if repo.SharedWithProjects != nil && !d.Get("share_with_all_projects").(bool) {
+		errors = setValue("shared_with_projects", *repo.SharedWithProjects)
+	}
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed to pack repository project share %q", errors)
+	}
+	return nil
+}
+
+func resourceRepositoryProjectShareUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(utilsdk.ProvderMetadata).Client
+	repoKey := d.Id()
+	readOnly := d.Get("read_only").(bool)
+
+	oldShareWithAll, newShareWithAll := d.GetChange("share_with_all_projects")
+	oldProjects, newProjects := d.GetChange("shared_with_projects")
+	old := sharedProjects(oldShareWithAll.(bool), oldProjects)
+	current := sharedProjects(newShareWithAll.(bool), newProjects)
+
+	kept := map[string]bool{}
+	for _, projectKey := range current {
+		kept[projectKey] = true
+	}
+	shared := map[string]bool{}
+	for _, projectKey := range old {
+		if kept[projectKey] {
+			shared[projectKey] = true
+			continue
+		}
+		if err := unshareRepository(client, repoKey, projectKey); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for _, projectKey := range current {
+		// projects already shared with are shared with again when the access changes
+		if shared[projectKey] && !d.HasChange("read_only") {
+			continue
+		}
+		if err := shareRepository(client, repoKey, projectKey, readOnly); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceRepositoryProjectShareRead(ctx, d, m)
+}
+
+func resourceRepositoryProjectShareDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(utilsdk.ProvderMetadata).Client
+	for _, projectKey := range sharedProjects(d.Get("share_with_all_projects").(bool), d.Get("shared_with_projects")) {
+		if err := unshareRepository(client, d.Id(), projectKey); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
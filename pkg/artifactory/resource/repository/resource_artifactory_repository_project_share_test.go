@@ -0,0 +1,179 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository"
+	"github.com/jfrog/terraform-provider-shared/client"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepositoryProjectShareRead(t *testing.T) {
+	for _, testCase := range []struct {
+		name         string
+		config       string
+		shareWithAll bool
+		readOnly     bool
+		projects     []interface{}
+	}{
+		{
+			name:     "shared read-only",
+			config:   `{"key":"maven-remote","sharedWithProjects":["proj1"],"sharedWithAllProjects":false,"readOnly":true}`,
+			readOnly: true,
+			projects: []interface{}{"proj1"},
+		},
+		{
+			name:         "shared with all the projects",
+			config:       `{"key":"maven-remote","sharedWithProjects":[],"sharedWithAllProjects":true,"readOnly":false}`,
+			shareWithAll: true,
+			projects:     []interface{}{"proj1", "proj2"},
+		},
+		{
+			name:     "not returned",
+			config:   `{"key":"maven-remote"}`,
+			projects: []interface{}{"proj1", "proj2"},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(testCase.config))
+			}))
+			defer server.Close()
+
+			restyClient, err := client.Build(server.URL, "terraform-provider-artifactory/test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			restyClient, err = client.AddAuth(restyClient, "", "test-token")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := repository.ResourceArtifactoryRepositoryProjectShare()
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				"repo_key":             "maven-remote",
+				"shared_with_projects": []interface{}{"proj1", "proj2"},
+			})
+			d.SetId("maven-remote")
+
+			diags := r.ReadContext(context.Background(), d, utilsdk.ProvderMetadata{Client: restyClient})
+			assert.False(t, diags.HasError(), "%v", diags)
+			assert.Equal(t, testCase.shareWithAll, d.Get("share_with_all_projects"))
+			assert.Equal(t, testCase.readOnly, d.Get("read_only"))
+			assert.ElementsMatch(t, testCase.projects, d.Get("shared_with_projects").(*schema.Set).List())
+		})
+	}
+}
+
+func TestAccRepositoryProjectShare(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("tf-share-", "artifactory_repository_project_share")
+	_, _, repoName := testutil.MkNames("tf-local-", "artifactory_local_generic_repository")
+	projectKey1 := fmt.Sprintf("tfshare%d", testutil.RandomInt())
+	projectKey2 := fmt.Sprintf("tfshare%d", testutil.RandomInt())
+
+	const template = `
+		resource "artifactory_local_generic_repository" "{{ .repo_name }}" {
+			key = "{{ .repo_name }}"
+		}
+
+		resource "artifactory_repository_project_share" "{{ .name }}" {
+			repo_key             = artifactory_local_generic_repository.{{ .repo_name }}.key
+			shared_with_projects = [{{ .projects }}]
+			read_only            = {{ .read_only }}
+		}
+	`
+	testData := map[string]string{
+		"name":      name,
+		"repo_name": repoName,
+		"projects":  fmt.Sprintf("%q", projectKey1),
+		"read_only": "false",
+	}
+
+	const allProjectsTemplate = `
+		resource "artifactory_local_generic_repository" "{{ .repo_name }}" {
+			key = "{{ .repo_name }}"
+		}
+
+		resource "artifactory_repository_project_share" "{{ .name }}" {
+			repo_key                = artifactory_local_generic_repository.{{ .repo_name }}.key
+			share_with_all_projects = true
+			read_only               = true
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.CreateProject(t, projectKey1)
+			acctest.CreateProject(t, projectKey2)
+		},
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			acctest.DeleteProject(t, projectKey1)
+			acctest.DeleteProject(t, projectKey2)
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: utilsdk.ExecuteTemplate("TestAccRepositoryProjectShare", template, testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "repo_key", repoName),
+					resource.TestCheckResourceAttr(fqrn, "shared_with_projects.#", "1"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "shared_with_projects.*", projectKey1),
+					resource.TestCheckResourceAttr(fqrn, "read_only", "false"),
+				),
+			},
+			{
+				Config: utilsdk.ExecuteTemplate("TestAccRepositoryProjectShare", template, utilsdk.MergeMaps(testData, map[string]string{
+					"projects":  fmt.Sprintf("%q", projectKey2),
+					"read_only": "true",
+				})),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "shared_with_projects.#", "1"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "shared_with_projects.*", projectKey2),
+					resource.TestCheckResourceAttr(fqrn, "read_only", "true"),
+				),
+			},
+			{
+				ResourceName:      fqrn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				PreConfig: func() {
+					_, err := acctest.GetTestResty(t).R().
+						SetPathParam("repoKey", repoName).
+						SetPathParam("projectKey", projectKey2).
+						Delete(repository.ShareRepositoryEndpoint)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: utilsdk.ExecuteTemplate("TestAccRepositoryProjectShare", template, utilsdk.MergeMaps(testData, map[string]string{
+					"projects":  fmt.Sprintf("%q", projectKey2),
+					"read_only": "true",
+				})),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: utilsdk.ExecuteTemplate("TestAccRepositoryProjectShare", allProjectsTemplate, testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "share_with_all_projects", "true"),
+					resource.TestCheckResourceAttr(fqrn, "shared_with_projects.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+const TasksEndpoint = "artifactory/api/tasks"
+
+type reindexEndpoint struct {
+	path string
+	// sync is true when the endpoint takes `async=0` to return once the calculation finished, otherwise the
+	// background tasks are polled.
+	sync bool
+	// taskType is part of the type of the background tasks calculating the index, e.g. `helm` for
+	// `org.artifactory.addon.helm.HelmReindexJob`, compared case insensitively.
+	taskType string
+}
+
+// ReindexEndpoints are the endpoints recalculating the index or metadata of a repository, by package type.
+var ReindexEndpoints = map[string]reindexEndpoint{
+	"cargo":  {path: "artifactory/api/cargo/{key}/reindex", taskType: "cargo"},
+	"conan":  {path: "artifactory/api/conan/{key}/reindex", taskType: "conan"},
+	"debian": {path: "artifactory/api/deb/reindex/{key}", sync: true},
+	"helm":   {path: "artifactory/api/helm/{key}/reindex", taskType: "helm"},
+	"maven":  {path: "artifactory/api/maven/calculateMetadata/{key}", taskType: "maven"},
+	"npm":    {path: "artifactory/api/npm/{key}/reindex", taskType: "npm"},
+	"nuget":  {path: "artifactory/api/nuget/{key}/reindex", taskType: "nuget"},
+	"pypi":   {path: "artifactory/api/pypi/{key}/reindex", taskType: "pypi"},
+	"rpm":    {path: "artifactory/api/yum/{key}", sync: true},
+}
+
+type BackgroundTask struct {
+	Id          string `json:"id"`
+	Type        string `json:"type"`
+	State       string `json:"state"`
+	Description string `json:"description"`
+}
+
+type backgroundTasks struct {
+	Tasks []BackgroundTask `json:"tasks"`
+}
+
+// IsPendingReindexTask returns true if the task is a scheduled or running task of type taskType, whose description
+// refers to the repository repoKey, as a whole word so `libs` doesn't match a task of `libs-release`.
+func IsPendingReindexTask(task BackgroundTask, taskType, repoKey string) bool {
+	state := strings.ToLower(task.State)
+	if state != "scheduled" && state != "running" {
+		return false
+	}
+	if !strings.Contains(strings.ToLower(task.Type), taskType) {
+		return false
+	}
+
+	// the characters allowed in repository keys are part of the key, any other character separates it
+	return slices.Contains(strings.FieldsFunc(task.Description, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("-_.", r)
+	}), repoKey)
+}
+
+func ResourceArtifactoryRepositoryReindex() *schema.Resource {
+	packageTypes := maps.Keys(ReindexEndpoints)
+	slices.Sort(packageTypes)
+
+	return &schema.Resource{
+		CreateContext: resourceRepositoryReindexCreate,
+		ReadContext:   resourceRepositoryReindexRead,
+		DeleteContext: resourceRepositoryReindexDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repo_key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				Description:      fmt.Sprintf("Key of the repository to reindex. Supported package types: %s.", strings.Join(packageTypes, ", ")),
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Folder to calculate the metadata of, e.g. `org/acme/foo`. Only supported for `maven` repositories. Default to the whole repository.",
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+				Description: "Wait until the calculation finishes, up to the create timeout. `debian` and `rpm` metadata are calculated " +
+					"synchronously, for other package types the background tasks of Artifactory are polled. Default to `false`.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, reindexes the repository again.",
+			},
+			"package_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Package type of the repository, which determines the reindex endpoint.",
+			},
+		},
+		Description: "Recalculates the index or metadata of a repository, e.g. after a bulk import. The repository is reindexed when " +
+			"the resource is created, and again whenever an argument, e.g. `triggers`, changes. Deleting the resource only removes it from the state.",
+	}
+}
+
+func resourceRepositoryReindexCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	data := &utilsdk.ResourceData{ResourceData: d}
+	client := m.(utilsdk.ProvderMetadata).Client
+	repoKey := data.GetString("repo_key", false)
+
+	repo := struct {
+		PackageType string `json:"packageType"`
+	}{}
+	_, err := client.R().
+		SetResult(&repo).
+		SetPathParam("key", repoKey).
+		Get(RepositoriesEndpoint)
+	if err != nil {
+		return diag.Errorf("failed to read repository %s: %s", repoKey, err)
+	}
+
+	endpoint, ok := ReindexEndpoints[repo.PackageType]
+	if !ok {
+		return diag.Errorf("reindexing %s repositories is not supported, repository %s", repo.PackageType, repoKey)
+	}
+
+	url := endpoint.path
+	if path := strings.Trim(data.GetString("path", false), "/"); path != "" {
+		if repo.PackageType != "maven" {
+			return diag.Errorf("path is only supported for maven repositories, repository %s is a %s repository", repoKey, repo.PackageType)
+		}
+		url += "/" + path
+	}
+
+	wait := data.GetBool("wait_for_completion", false)
+	request := client.R().SetPathParam("key", repoKey)
+	if endpoint.sync {
+		async := "1"
+		if wait {
+			async = "0"
+		}
+		request.SetQueryParam("async", async)
+	}
+	if _, err := request.Post(url); err != nil {
+		return diag.Errorf("failed to reindex repository %s: %s", repoKey, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", repoKey, time.Now().UnixNano()))
+	setValue := utilsdk.MkLens(d)
+	errors := setValue("package_type", repo.PackageType)
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed to pack repository reindex %q", errors)
+	}
+
+	if wait && !endpoint.sync {
+		if err := waitForBackgroundTasks(ctx, d, m, endpoint.taskType, repoKey); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// reindexTaskGracePeriod is how long a reindex task may take to show up in the background tasks after the reindex
+// was requested. No pending task after it means the reindex already finished.
+const reindexTaskGracePeriod = 30 * time.Second
+
+// waitForBackgroundTasks polls the background tasks of Artifactory until none of the scheduled or running reindex
+// tasks refers to the repository, once such a task was seen or the grace period for it to be scheduled is over.
+func waitForBackgroundTasks(ctx context.Context, d *schema.ResourceData, m interface{}, taskType, repoKey string) error {
+	start := time.Now()
+	seen := false
+	return retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		tasks := backgroundTasks{}
+		_, err := m.(utilsdk.ProvderMetadata).Client.R().SetResult(&tasks).Get(TasksEndpoint)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to read background tasks: %s", err))
+		}
+
+		for _, task := range tasks.Tasks {
+			if IsPendingReindexTask(task, taskType, repoKey) {
+				seen = true
+				return retry.RetryableError(fmt.Errorf("reindex of repository %s is still %s (task %s)", repoKey, strings.ToLower(task.State), task.Id))
+			}
+		}
+		if !seen && time.Since(start) < reindexTaskGracePeriod {
+			return retry.RetryableError(fmt.Errorf("reindex of repository %s is not scheduled yet", repoKey))
+		}
+		return nil
+	})
+}
+
+// resourceRepositoryReindexRead keeps the state as is, a reindex has no remote state to read.
+func resourceRepositoryReindexRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceRepositoryReindexDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,193 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/resource/repository"
+	"github.com/jfrog/terraform-provider-shared/client"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPendingReindexTask(t *testing.T) {
+	task := func(taskType, state, description string) repository.BackgroundTask {
+		return repository.BackgroundTask{Id: "1", Type: taskType, State: state, Description: description}
+	}
+
+	for _, testCase := range []struct {
+		name     string
+		task     repository.BackgroundTask
+		expected bool
+	}{
+		{"running", task("org.artifactory.addon.helm.HelmReindexJob", "running", "Reindexing repository libs"), true},
+		{"scheduled", task("org.artifactory.addon.helm.HelmReindexJob", "SCHEDULED", "Reindex 'libs'"), true},
+		{"finished", task("org.artifactory.addon.helm.HelmReindexJob", "stopped", "Reindexing repository libs"), false},
+		{"other task type", task("org.artifactory.repo.cleanup.ArtifactCleanupJob", "running", "Cleanup of libs"), false},
+		{"key with a suffix", task("org.artifactory.addon.helm.HelmReindexJob", "running", "Reindexing repository libs-release"), false},
+		{"key with a prefix", task("org.artifactory.addon.helm.HelmReindexJob", "running", "Reindexing repository my.libs"), false},
+		{"key in a path", task("org.artifactory.addon.helm.HelmReindexJob", "running", "Reindexing libs/charts"), true},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, repository.IsPendingReindexTask(testCase.task, "helm", "libs"))
+		})
+	}
+}
+
+func TestRepositoryReindex_waitsForTheTaskToBeScheduled(t *testing.T) {
+	// the reindex task only shows up in the background tasks on the third poll
+	tasks := []string{
+		`{"tasks":[]}`,
+		`{"tasks":[]}`,
+		`{"tasks":[{"id":"1","type":"org.artifactory.addon.helm.HelmReindexJob","state":"running","description":"Reindexing repository libs"}]}`,
+		`{"tasks":[]}`,
+	}
+	var mu sync.Mutex
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/artifactory/api/repositories/libs":
+			w.Write([]byte(`{"key":"libs","packageType":"helm"}`))
+		case "/artifactory/api/tasks":
+			mu.Lock()
+			defer mu.Unlock()
+			w.Write([]byte(tasks[polls]))
+			if polls < len(tasks)-1 {
+				polls++
+			}
+		}
+	}))
+	defer server.Close()
+
+	restyClient, err := client.Build(server.URL, "terraform-provider-artifactory/test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	restyClient, err = client.AddAuth(restyClient, "", "test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := repository.ResourceArtifactoryRepositoryReindex()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"repo_key":            "libs",
+		"wait_for_completion": true,
+	})
+	diags := r.CreateContext(context.Background(), d, utilsdk.ProvderMetadata{Client: restyClient})
+	assert.False(t, diags.HasError(), "%v", diags)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, len(tasks)-1, polls, "expected to poll until the task finished")
+}
+
+func TestAccRepositoryReindex(t *testing.T) {
+	testCases := []struct {
+		packageType string
+		waitFor     string
+	}{
+		{"debian", "true"},
+		{"helm", "true"},
+		{"maven", "false"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.packageType, func(t *testing.T) {
+			_, fqrn, name := testutil.MkNames("tf-reindex-", "artifactory_repository_reindex")
+			_, _, repoName := testutil.MkNames("tf-local-"+tc.packageType+"-", "artifactory_local_"+tc.packageType+"_repository")
+			repoFqrn := fmt.Sprintf("artifactory_local_%s_repository.%s", tc.packageType, repoName)
+
+			const template = `
+				resource "artifactory_local_{{ .package_type }}_repository" "{{ .repo_name }}" {
+					key = "{{ .repo_name }}"
+				}
+
+				resource "artifactory_repository_reindex" "{{ .name }}" {
+					repo_key            = artifactory_local_{{ .package_type }}_repository.{{ .repo_name }}.key
+					wait_for_completion = {{ .wait }}
+					triggers = {
+						import = "{{ .import }}"
+					}
+				}
+			`
+			testData := map[string]string{
+				"name":         name,
+				"repo_name":    repoName,
+				"package_type": tc.packageType,
+				"wait":         tc.waitFor,
+				"import":       "1",
+			}
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:          func() { acctest.PreCheck(t) },
+				ProviderFactories: acctest.ProviderFactories,
+				CheckDestroy:      acctest.VerifyDeleted(repoFqrn, acctest.CheckRepo),
+				Steps: []resource.TestStep{
+					{
+						Config: utilsdk.ExecuteTemplate("TestAccRepositoryReindex", template, testData),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(fqrn, "package_type", tc.packageType),
+							resource.TestCheckResourceAttr(fqrn, "triggers.import", "1"),
+						),
+					},
+					{
+						Config: utilsdk.ExecuteTemplate("TestAccRepositoryReindex", template, utilsdk.MergeMaps(testData, map[string]string{"import": "2"})),
+						Check:  resource.TestCheckResourceAttr(fqrn, "triggers.import", "2"),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccRepositoryReindex_unsupported(t *testing.T) {
+	_, _, name := testutil.MkNames("tf-reindex-", "artifactory_repository_reindex")
+	_, _, repoName := testutil.MkNames("tf-local-", "artifactory_local_npm_repository")
+
+	const template = `
+		resource "artifactory_local_{{ .package_type }}_repository" "{{ .repo_name }}" {
+			key = "{{ .repo_name }}"
+		}
+
+		resource "artifactory_repository_reindex" "{{ .name }}" {
+			repo_key = artifactory_local_{{ .package_type }}_repository.{{ .repo_name }}.key
+			path     = "{{ .path }}"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted("artifactory_local_npm_repository."+repoName, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: utilsdk.ExecuteTemplate("TestAccRepositoryReindex", template, map[string]string{
+					"name":         name,
+					"repo_name":    repoName,
+					"package_type": "generic",
+					"path":         "",
+				}),
+				ExpectError: regexp.MustCompile(".*reindexing generic repositories is not supported.*"),
+			},
+			{
+				Config: utilsdk.ExecuteTemplate("TestAccRepositoryReindex", template, map[string]string{
+					"name":         name,
+					"repo_name":    repoName,
+					"package_type": "npm",
+					"path":         "org/acme",
+				}),
+				ExpectError: regexp.MustCompile(".*path is only supported for maven repositories.*"),
+			},
+		},
+	})
+}
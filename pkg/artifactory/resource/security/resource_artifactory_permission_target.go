@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/antpath"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	utilsdk "github.com/jfrog/terraform-provider-shared/util/sdk"
 	validatorfw "github.com/jfrog/terraform-provider-shared/validator/fw"
@@ -301,6 +302,9 @@ func (r *PermissionTargetResource) getPrincipalBlock(description, repoDescriptio
 					Computed:            true,
 					Default:             setdefault.StaticValue(basetypes.NewSetValueMust(types.StringType, []attr.Value{types.StringValue("**")})),
 					MarkdownDescription: `The default value will be [""] if nothing is supplied`,
+					Validators: []validator.Set{
+						antpath.PatternSetValidator(),
+					},
 				},
 				"excludes_pattern": schema.SetAttribute{
 					ElementType:         types.StringType,
@@ -308,6 +312,9 @@ func (r *PermissionTargetResource) getPrincipalBlock(description, repoDescriptio
 					Computed:            true,
 					Default:             setdefault.StaticValue(basetypes.NewSetValueMust(types.StringType, []attr.Value{})),
 					MarkdownDescription: "The default value will be [] if nothing is supplied",
+					Validators: []validator.Set{
+						antpath.ExcludesPatternSetValidator("includes_pattern"),
+					},
 				},
 				"repositories": schema.SetAttribute{
 					ElementType: types.StringType,
@@ -0,0 +1,49 @@
+// Package workerpool runs the tasks of a batch in parallel, e.g. the downloads of several files, with a bounded number
+// of tasks running at a time.
+package workerpool
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Run calls task with each of the items, running at most parallelism tasks at a time. It returns the values and the
+// errors of the tasks in the order of the items.
+func Run[T, R any](items []T, parallelism int, task func(T) (R, error)) ([]R, []error) {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	values := make([]R, len(items))
+	errs := make([]error, len(items))
+	semaphore := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i, item := range items {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, item T) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			values[i], errs[i] = task(item)
+		}(i, item)
+	}
+	wg.Wait()
+
+	return values, errs
+}
+
+// JoinFailures returns an error listing the errors of the failed tasks, e.g. `failed to download 2 of 5 files: ...`,
+// or nil if all the tasks succeeded. action names what the tasks do, e.g. `download`.
+func JoinFailures(action string, errs []error) error {
+	var failures []string
+	for _, err := range errs {
+		if err != nil {
+			failures = append(failures, err.Error())
+		}
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	return fmt.Errorf("failed to %s %d of %d files: %s", action, len(failures), len(errs), strings.Join(failures, "; "))
+}
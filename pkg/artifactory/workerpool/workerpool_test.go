@@ -0,0 +1,55 @@
+package workerpool_test
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jfrog/terraform-provider-artifactory/v8/pkg/artifactory/workerpool"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	items := []int{5, 1, 4, 2, 3, 0, 6, 7}
+
+	var running, maxRunning int32
+	values, errs := workerpool.Run(items, 3, func(item int) (string, error) {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			highest := atomic.LoadInt32(&maxRunning)
+			if current <= highest || atomic.CompareAndSwapInt32(&maxRunning, highest, current) {
+				break
+			}
+		}
+
+		time.Sleep(time.Duration(item) * time.Millisecond)
+		if item%2 == 1 {
+			return "", fmt.Errorf("odd %d", item)
+		}
+		return fmt.Sprintf("even %d", item), nil
+	})
+
+	assert.Equal(t, []string{"", "", "even 4", "even 2", "", "even 0", "even 6", ""}, values)
+	assert.Equal(t, []error{fmt.Errorf("odd 5"), fmt.Errorf("odd 1"), nil, nil, fmt.Errorf("odd 3"), nil, nil, fmt.Errorf("odd 7")}, errs)
+	assert.LessOrEqual(t, maxRunning, int32(3))
+}
+
+func TestRun_empty(t *testing.T) {
+	values, errs := workerpool.Run([]string{}, 4, func(item string) (string, error) {
+		return item, nil
+	})
+
+	assert.Empty(t, values)
+	assert.Empty(t, errs)
+}
+
+func TestJoinFailures(t *testing.T) {
+	assert.NoError(t, workerpool.JoinFailures("download", []error{nil, nil}))
+	assert.NoError(t, workerpool.JoinFailures("download", nil))
+	assert.EqualError(t,
+		workerpool.JoinFailures("download", []error{fmt.Errorf("foo failed"), nil, fmt.Errorf("bar failed")}),
+		"failed to download 2 of 3 files: foo failed; bar failed",
+	)
+}